@@ -0,0 +1,172 @@
+//go:build linux
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// auditEntry 是审计日志中的一条记录。每条记录携带上一条记录的哈希，
+// 自身的哈希覆盖除 hash 以外的全部字段，任何删改都会使哈希链断开
+type auditEntry struct {
+	Time             string  `json:"time"`
+	Method           string  `json:"method"`
+	Peer             string  `json:"peer,omitempty"`
+	ClientIdentity   string  `json:"client_identity,omitempty"` // TLS 客户端证书的 Subject
+	TokenFingerprint string  `json:"token_fingerprint,omitempty"`
+	Command          string  `json:"command,omitempty"`
+	Target           string  `json:"target,omitempty"` // 操作对象，如任务 ID
+	RunAsUser        string  `json:"run_as_user,omitempty"`
+	ExitCode         *int32  `json:"exit_code,omitempty"`
+	Duration         float64 `json:"duration"`    // 耗时（秒）
+	OutputSize       int64   `json:"output_size"` // 返回给客户端的输出字节数
+	Error            string  `json:"error,omitempty"`
+	PrevHash         string  `json:"prev_hash"`
+	Hash             string  `json:"hash,omitempty"`
+
+	start time.Time
+}
+
+// auditLog 以 JSON Lines 格式追加写入审计文件
+type auditLog struct {
+	mu       sync.Mutex
+	file     *os.File
+	lastHash string
+}
+
+// auditor 为 nil 时不记录审计日志
+var auditor *auditLog
+
+// openAuditLog 打开审计文件并校验已有记录的哈希链，新记录接在最后一条之后
+func openAuditLog(path string) (*auditLog, error) {
+	lastHash, err := verifyAuditLog(path)
+	if err != nil && !os.IsNotExist(err) {
+		// 哈希链损坏时仍继续记录，但必须让运维人员知道日志曾被篡改
+		log.Printf("审计日志校验失败: %v", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("打开审计日志失败: %v", err)
+	}
+	return &auditLog{file: file, lastHash: lastHash}, nil
+}
+
+// verifyAuditLog 逐条校验审计文件的哈希链，返回最后一条记录的哈希和发现的第一个问题
+func verifyAuditLog(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	var lastHash string
+	var firstErr error
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var entry auditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("第 %d 行无法解析: %v", line, err)
+			}
+			continue
+		}
+		if firstErr == nil && entry.PrevHash != lastHash {
+			firstErr = fmt.Errorf("第 %d 行的 prev_hash 与上一条记录不一致", line)
+		}
+		if firstErr == nil && entry.computeHash() != entry.Hash {
+			firstErr = fmt.Errorf("第 %d 行的哈希不匹配，记录可能已被修改", line)
+		}
+		lastHash = entry.Hash
+	}
+	if firstErr == nil {
+		firstErr = scanner.Err()
+	}
+	return lastHash, firstErr
+}
+
+// newAuditEntry 在 RPC 开始时记录调用方信息
+func newAuditEntry(ctx context.Context, method string) *auditEntry {
+	now := time.Now()
+	entry := &auditEntry{
+		Time:   now.UTC().Format(time.RFC3339Nano),
+		Method: method,
+		start:  now,
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		entry.Peer = p.Addr.String()
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.PeerCertificates) > 0 {
+			entry.ClientIdentity = info.State.PeerCertificates[0].Subject.String()
+		}
+	}
+	if token := requestToken(ctx); token != "" {
+		entry.TokenFingerprint = tokenFingerprint(token)
+	}
+	return entry
+}
+
+// tokenFingerprint 返回令牌 SHA-256 的前 16 位十六进制，用于区分调用方而不泄露令牌
+func tokenFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
+
+// setExit 记录命令的退出码
+func (e *auditEntry) setExit(exitCode int32) {
+	e.ExitCode = &exitCode
+}
+
+// commit 补全耗时和错误信息后写入审计日志
+func (e *auditEntry) commit(err error) {
+	if auditor == nil {
+		return
+	}
+	e.Duration = time.Since(e.start).Seconds()
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if writeErr := auditor.append(e); writeErr != nil {
+		log.Printf("写入审计日志失败: %v", writeErr)
+	}
+}
+
+func (l *auditLog) append(e *auditEntry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e.PrevHash = l.lastHash
+	e.Hash = e.computeHash()
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if err := l.file.Sync(); err != nil {
+		return err
+	}
+	l.lastHash = e.Hash
+	return nil
+}
+
+// computeHash 计算不含 hash 字段的记录的 SHA-256
+func (e *auditEntry) computeHash() string {
+	clone := *e
+	clone.Hash = ""
+	data, _ := json.Marshal(&clone)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
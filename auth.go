@@ -1,14 +1,18 @@
+//go:build linux
+
 package main
 
 import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"google.golang.org/grpc/metadata"
 )
 
 // AuthInterceptor checks the token in the gRPC metadata.
+// Besides AUTH_TOKEN, any token listed in ROOT_TOKENS is accepted.
 func AuthInterceptor(ctx context.Context) error {
 	validToken := os.Getenv("AUTH_TOKEN")
 	if validToken == "" {
@@ -18,8 +22,58 @@ func AuthInterceptor(ctx context.Context) error {
 	if !ok {
 		return fmt.Errorf("missing metadata")
 	}
-	if tokens, ok := md["authorization"]; ok && len(tokens) > 0 && tokens[0] == validToken {
-		return nil
+	if tokens, ok := md["authorization"]; ok && len(tokens) > 0 {
+		if tokens[0] == validToken || containsToken(rootTokens(), tokens[0]) {
+			return nil
+		}
 	}
 	return fmt.Errorf("invalid token")
 }
+
+// requestToken returns the token the caller authenticated with.
+func requestToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if tokens := md["authorization"]; len(tokens) > 0 {
+		return tokens[0]
+	}
+	return ""
+}
+
+// tokenAllowsRoot reports whether the token may run commands as root.
+// When ROOT_TOKENS is unset every valid token may, which keeps the old behaviour.
+func tokenAllowsRoot(token string) bool {
+	allowed := rootTokens()
+	return len(allowed) == 0 || containsToken(allowed, token)
+}
+
+// rootTokens parses the comma separated ROOT_TOKENS variable.
+func rootTokens() []string {
+	var tokens []string
+	for _, token := range strings.Split(os.Getenv("ROOT_TOKENS"), ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+func containsToken(tokens []string, token string) bool {
+	for _, t := range tokens {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
+
+// requireRootToken rejects callers whose token may not act as root. File
+// operations run with the agent's own privileges, so they need the same trust.
+func requireRootToken(ctx context.Context) error {
+	if !tokenAllowsRoot(requestToken(ctx)) {
+		return fmt.Errorf("token is not allowed to perform privileged operations")
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// runAsUser 描述命令运行时使用的身份
+type runAsUser struct {
+	credential *syscall.Credential
+	env        map[string]string // 与目标用户对应的 HOME、USER、LOGNAME
+}
+
+// resolveRunAs 解析命令的运行身份。未指定用户时使用 DEFAULT_RUN_AS_USER，
+// 仍为空则沿用 agent 自身的身份；最终身份为 root 时要求令牌在 ROOT_TOKENS 中，
+// 不在其中的令牌也只能把 run_as_group 设为目标用户所属的组
+func resolveRunAs(ctx context.Context, userName, groupName string) (*runAsUser, error) {
+	if userName == "" {
+		userName = os.Getenv("DEFAULT_RUN_AS_USER")
+	}
+	if userName == "" {
+		if groupName != "" {
+			return nil, fmt.Errorf("指定 run_as_group 时必须同时指定 run_as_user")
+		}
+		if os.Geteuid() == 0 && !tokenAllowsRoot(requestToken(ctx)) {
+			return nil, fmt.Errorf("该令牌不允许以 root 身份执行命令")
+		}
+		return &runAsUser{}, nil
+	}
+
+	u, err := lookupUser(userName)
+	if err != nil {
+		return nil, fmt.Errorf("查找用户 %s 失败: %v", userName, err)
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("无效的用户 ID: %s", u.Uid)
+	}
+	gid, err := strconv.ParseUint(u.Gid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("无效的组 ID: %s", u.Gid)
+	}
+	if groupName != "" {
+		g, err := lookupGroup(groupName)
+		if err != nil {
+			return nil, fmt.Errorf("查找用户组 %s 失败: %v", groupName, err)
+		}
+		if gid, err = strconv.ParseUint(g.Gid, 10, 32); err != nil {
+			return nil, fmt.Errorf("无效的组 ID: %s", g.Gid)
+		}
+	}
+	if uid == 0 && !tokenAllowsRoot(requestToken(ctx)) {
+		return nil, fmt.Errorf("该令牌不允许以 root 身份执行命令")
+	}
+
+	// 附加组取目标用户所属的全部用户组
+	var groups []uint32
+	groupIDs, err := u.GroupIds()
+	if err != nil {
+		return nil, fmt.Errorf("获取用户 %s 的附加组失败: %v", userName, err)
+	}
+	for _, id := range groupIDs {
+		if g, err := strconv.ParseUint(id, 10, 32); err == nil {
+			groups = append(groups, uint32(g))
+		}
+	}
+	// 不能以 root 执行的令牌只能选择目标用户自己所属的组，且不能是 root 组
+	if groupName != "" && !tokenAllowsRoot(requestToken(ctx)) {
+		if gid == 0 {
+			return nil, fmt.Errorf("该令牌不允许以 root 组身份执行命令")
+		}
+		if strconv.FormatUint(gid, 10) != u.Gid && !containsGroup(groups, uint32(gid)) {
+			return nil, fmt.Errorf("用户 %s 不属于用户组 %s", u.Username, groupName)
+		}
+	}
+
+	return &runAsUser{
+		credential: &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid), Groups: groups},
+		env: map[string]string{
+			"HOME":    u.HomeDir,
+			"USER":    u.Username,
+			"LOGNAME": u.Username,
+		},
+	}, nil
+}
+
+// apply 把运行身份写入 SysProcAttr，并在环境变量中补上目标用户的 HOME 等变量，
+// 请求中显式指定的变量优先
+func (r *runAsUser) apply(attr *syscall.SysProcAttr, env map[string]string) map[string]string {
+	if r.credential == nil {
+		return env
+	}
+	attr.Credential = r.credential
+	merged := make(map[string]string, len(r.env)+len(env))
+	for key, value := range r.env {
+		merged[key] = value
+	}
+	for key, value := range env {
+		merged[key] = value
+	}
+	return merged
+}
+
+func containsGroup(groups []uint32, gid uint32) bool {
+	for _, g := range groups {
+		if g == gid {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupUser 按用户名或数字 uid 查找用户
+func lookupUser(name string) (*user.User, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		return user.LookupId(name)
+	}
+	return user.Lookup(name)
+}
+
+// lookupGroup 按组名或数字 gid 查找用户组
+func lookupGroup(name string) (*user.Group, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		return user.LookupGroupId(name)
+	}
+	return user.LookupGroup(name)
+}
@@ -0,0 +1,377 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "server_agent/module/proto"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// newDockerClient 按环境变量（DOCKER_HOST 等）连接 Docker，并与守护进程协商 API 版本，
+// 以便兼容版本较旧的 Docker
+func newDockerClient() (*client.Client, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("连接 Docker 失败: %v", err)
+	}
+	return cli, nil
+}
+
+// StartContainer 启动容器
+func (s *ResourceCheckerServer) StartContainer(ctx context.Context, req *pb.ContainerRequest) (resp *pb.ContainerActionResponse, err error) {
+	audit := newAuditEntry(ctx, "StartContainer")
+	audit.Target = req.Id
+	defer func() { audit.commit(err) }()
+
+	return controlContainer(ctx, req.Id, func(cli *client.Client) error {
+		return cli.ContainerStart(ctx, req.Id, types.ContainerStartOptions{})
+	})
+}
+
+// StopContainer 向容器发送停止信号，超时后强制杀死
+func (s *ResourceCheckerServer) StopContainer(ctx context.Context, req *pb.StopContainerRequest) (resp *pb.ContainerActionResponse, err error) {
+	audit := newAuditEntry(ctx, "StopContainer")
+	audit.Target = req.Id
+	defer func() { audit.commit(err) }()
+
+	return controlContainer(ctx, req.Id, func(cli *client.Client) error {
+		return cli.ContainerStop(ctx, req.Id, stopOptions(req))
+	})
+}
+
+// RestartContainer 重启容器，超时的含义与 StopContainer 相同
+func (s *ResourceCheckerServer) RestartContainer(ctx context.Context, req *pb.StopContainerRequest) (resp *pb.ContainerActionResponse, err error) {
+	audit := newAuditEntry(ctx, "RestartContainer")
+	audit.Target = req.Id
+	defer func() { audit.commit(err) }()
+
+	return controlContainer(ctx, req.Id, func(cli *client.Client) error {
+		return cli.ContainerRestart(ctx, req.Id, stopOptions(req))
+	})
+}
+
+// PauseContainer 暂停容器内的全部进程
+func (s *ResourceCheckerServer) PauseContainer(ctx context.Context, req *pb.ContainerRequest) (resp *pb.ContainerActionResponse, err error) {
+	audit := newAuditEntry(ctx, "PauseContainer")
+	audit.Target = req.Id
+	defer func() { audit.commit(err) }()
+
+	return controlContainer(ctx, req.Id, func(cli *client.Client) error {
+		return cli.ContainerPause(ctx, req.Id)
+	})
+}
+
+// UnpauseContainer 恢复被暂停的容器
+func (s *ResourceCheckerServer) UnpauseContainer(ctx context.Context, req *pb.ContainerRequest) (resp *pb.ContainerActionResponse, err error) {
+	audit := newAuditEntry(ctx, "UnpauseContainer")
+	audit.Target = req.Id
+	defer func() { audit.commit(err) }()
+
+	return controlContainer(ctx, req.Id, func(cli *client.Client) error {
+		return cli.ContainerUnpause(ctx, req.Id)
+	})
+}
+
+// KillContainer 向容器的主进程发送信号
+func (s *ResourceCheckerServer) KillContainer(ctx context.Context, req *pb.KillContainerRequest) (resp *pb.ContainerActionResponse, err error) {
+	audit := newAuditEntry(ctx, "KillContainer")
+	audit.Target = req.Id
+	audit.Command = req.Signal
+	defer func() { audit.commit(err) }()
+
+	// 信号名放在 controlContainer 鉴权之后解析，与其他接口的检查顺序一致
+	return controlContainer(ctx, req.Id, func(cli *client.Client) error {
+		name := req.Signal
+		if name == "" {
+			name = "KILL"
+		}
+		sig, err := parseSignal(name)
+		if err != nil {
+			return err
+		}
+		return cli.ContainerKill(ctx, req.Id, signalName(sig))
+	})
+}
+
+// RemoveContainer 删除容器，运行中的容器需要设置 force
+func (s *ResourceCheckerServer) RemoveContainer(ctx context.Context, req *pb.RemoveContainerRequest) (resp *pb.ContainerActionResponse, err error) {
+	audit := newAuditEntry(ctx, "RemoveContainer")
+	audit.Target = req.Id
+	defer func() { audit.commit(err) }()
+
+	return controlContainer(ctx, req.Id, func(cli *client.Client) error {
+		return cli.ContainerRemove(ctx, req.Id, types.ContainerRemoveOptions{Force: req.Force, RemoveVolumes: req.RemoveVolumes})
+	})
+}
+
+// ContainerLogs 推送容器日志，标准输出和标准错误分别作为 stdout、stderr 消息发送
+func (s *ResourceCheckerServer) ContainerLogs(req *pb.ContainerLogsRequest, stream pb.ResourceChecker_ContainerLogsServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "ContainerLogs")
+	audit.Target = req.Id
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
+	}
+	// 日志中可能包含敏感信息
+	if err := requireRootToken(ctx); err != nil {
+		return err
+	}
+	if req.Id == "" {
+		return fmt.Errorf("需要指定容器")
+	}
+
+	cli, err := newDockerClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	info, err := cli.ContainerInspect(ctx, req.Id)
+	if err != nil {
+		return fmt.Errorf("查找容器 %s 失败: %v", req.Id, err)
+	}
+	options := types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     req.Follow,
+		Since:      req.Since,
+		Until:      req.Until,
+		Timestamps: req.Timestamps,
+		Tail:       "all",
+	}
+	if req.Tail != nil {
+		options.Tail = strconv.Itoa(int(*req.Tail))
+	}
+	logs, err := cli.ContainerLogs(ctx, info.ID, options)
+	if err != nil {
+		return fmt.Errorf("获取容器 %s 日志失败: %v", req.Id, err)
+	}
+	defer logs.Close()
+
+	stdout := &chunkWriter{audit: audit, send: func(data []byte) error {
+		return stream.Send(&pb.ContainerLogChunk{Payload: &pb.ContainerLogChunk_Stdout{Stdout: data}})
+	}}
+	stderr := &chunkWriter{audit: audit, send: func(data []byte) error {
+		return stream.Send(&pb.ContainerLogChunk{Payload: &pb.ContainerLogChunk_Stderr{Stderr: data}})
+	}}
+	// 使用 TTY 的容器日志没有多路复用的帧头，直接原样转发
+	if info.Config != nil && info.Config.Tty {
+		_, err = io.Copy(stdout, logs)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, logs)
+	}
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("读取容器日志失败: %v", err)
+	}
+	return nil
+}
+
+// ContainerExec 在容器中执行命令并转发输入输出，命令结束后发送退出信息。
+// Docker 不支持终止 exec 进程，客户端断开只会关闭连接，进程是否退出取决于它自己
+func (s *ResourceCheckerServer) ContainerExec(stream pb.ResourceChecker_ContainerExecServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "ContainerExec")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
+	}
+	// 能进入容器执行命令等同于拥有 root 权限
+	if err := requireRootToken(ctx); err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := first.GetStart()
+	if start == nil {
+		return fmt.Errorf("首条消息必须为 start")
+	}
+	command := start.Command
+	if len(command) == 0 {
+		command = []string{"/bin/sh"}
+	}
+	audit.Target, audit.Command, audit.RunAsUser = start.Id, strings.Join(command, " "), start.User
+	if start.Id == "" {
+		return fmt.Errorf("需要指定容器")
+	}
+
+	cli, err := newDockerClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	var env []string
+	for k, v := range start.Env {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	config := types.ExecConfig{
+		User:         start.User,
+		Tty:          start.Tty,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Env:          env,
+		WorkingDir:   start.WorkingDir,
+		Cmd:          command,
+	}
+	if start.Tty && start.Size != nil {
+		config.ConsoleSize = &[2]uint{uint(start.Size.Rows), uint(start.Size.Cols)}
+	}
+	created, err := cli.ContainerExecCreate(ctx, start.Id, config)
+	if err != nil {
+		return fmt.Errorf("在容器 %s 中创建执行失败: %v", start.Id, err)
+	}
+	attached, err := cli.ContainerExecAttach(ctx, created.ID, types.ExecStartCheck{Tty: start.Tty, ConsoleSize: config.ConsoleSize})
+	if err != nil {
+		return fmt.Errorf("连接容器执行失败: %v", err)
+	}
+	defer attached.Close()
+	began := time.Now()
+	fmt.Printf("容器 %s 中执行: %s\n", start.Id, audit.Command)
+
+	// 转发 exec 的输出直到 Docker 关闭连接；退出信息要等这个协程结束后再发送，
+	// 避免与它同时调用 stream.Send
+	outputDone := make(chan struct{})
+	go func() {
+		defer close(outputDone)
+		stdout := &chunkWriter{audit: audit, send: func(data []byte) error {
+			return stream.Send(&pb.ShellChunk{Payload: &pb.ShellChunk_Stdout{Stdout: data}})
+		}}
+		stderr := &chunkWriter{audit: audit, send: func(data []byte) error {
+			return stream.Send(&pb.ShellChunk{Payload: &pb.ShellChunk_Stderr{Stderr: data}})
+		}}
+		if start.Tty {
+			io.Copy(stdout, attached.Reader)
+		} else {
+			stdcopy.StdCopy(stdout, stderr, attached.Reader)
+		}
+	}()
+
+	go func() {
+		for {
+			in, err := stream.Recv()
+			if err != nil {
+				// 客户端关闭发送方向（io.EOF）等同于关闭标准输入，命令仍可继续输出；
+				// 客户端断开或流出错时关闭连接，让输出协程结束
+				if err == io.EOF && ctx.Err() == nil {
+					attached.CloseWrite()
+					<-ctx.Done()
+				}
+				attached.Close()
+				return
+			}
+			switch payload := in.Payload.(type) {
+			case *pb.ContainerExecInput_Stdin:
+				attached.Conn.Write(payload.Stdin)
+			case *pb.ContainerExecInput_CloseStdin:
+				attached.CloseWrite()
+			case *pb.ContainerExecInput_Resize:
+				if start.Tty && payload.Resize != nil {
+					cli.ContainerExecResize(ctx, created.ID, types.ResizeOptions{Height: uint(payload.Resize.Rows), Width: uint(payload.Resize.Cols)})
+				}
+			}
+		}
+	}()
+
+	<-outputDone
+	exit := &pb.ShellExit{ExitCode: -1}
+	// 输出流结束时进程通常已经退出，但 Docker 更新退出状态可能稍有延迟
+	for i := 0; i < 10; i++ {
+		inspect, err := cli.ContainerExecInspect(ctx, created.ID)
+		if err != nil {
+			exit.Error = err.Error()
+			break
+		}
+		if !inspect.Running {
+			exit.ExitCode = int32(inspect.ExitCode)
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	exit.Duration = time.Since(began).Seconds()
+	audit.setExit(exit.ExitCode)
+	return stream.Send(&pb.ShellChunk{Payload: &pb.ShellChunk_Exit{Exit: exit}})
+}
+
+// chunkWriter 把每次写入的数据作为一条消息发送给客户端
+type chunkWriter struct {
+	send  func(data []byte) error
+	audit *auditEntry
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	if err := w.send(append([]byte(nil), p...)); err != nil {
+		return 0, err
+	}
+	w.audit.OutputSize += int64(len(p))
+	return len(p), nil
+}
+
+// controlContainer 完成鉴权后对容器执行操作，返回操作后的容器状态。
+// 操作前先查询一次容器，既能给出明确的“不存在”错误，也能在删除后返回容器的完整 ID 和名称
+func controlContainer(ctx context.Context, id string, action func(cli *client.Client) error) (*pb.ContainerActionResponse, error) {
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	// 能控制 Docker 等同于拥有 root 权限
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, fmt.Errorf("需要指定容器")
+	}
+
+	cli, err := newDockerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Close()
+
+	before, err := cli.ContainerInspect(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("查找容器 %s 失败: %v", id, err)
+	}
+	resp := &pb.ContainerActionResponse{Id: before.ID, Name: strings.TrimPrefix(before.Name, "/")}
+	if err := action(cli); err != nil {
+		return nil, fmt.Errorf("操作容器 %s 失败: %v", id, err)
+	}
+
+	after, err := cli.ContainerInspect(ctx, before.ID)
+	switch {
+	case client.IsErrNotFound(err):
+		resp.State = "removed"
+	case err != nil:
+		return nil, fmt.Errorf("查询容器 %s 状态失败: %v", id, err)
+	case after.State != nil:
+		resp.State = after.State.Status
+	}
+	return resp, nil
+}
+
+func stopOptions(req *pb.StopContainerRequest) container.StopOptions {
+	var options container.StopOptions
+	if req.TimeoutSeconds != nil {
+		timeout := int(*req.TimeoutSeconds)
+		options.Timeout = &timeout
+	}
+	return options
+}
@@ -0,0 +1,351 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	pb "server_agent/module/proto"
+
+	"golang.org/x/sys/unix"
+)
+
+// fileChunkSize 是文件传输时每条消息携带的数据量
+const fileChunkSize = 256 * 1024
+
+// uploadTempPath 返回上传过程中使用的临时文件，与目标文件位于同一目录以便原子重命名，
+// 文件名固定，断线后可以据此续传
+func uploadTempPath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".upload")
+}
+
+// openUploadTemp 打开上传使用的临时文件。临时文件名可以预测，且位于其他用户可能有写权限的目录中，
+// 因此不跟随符号链接：新上传先删除旧的临时文件（符号链接只删除链接本身），再以 O_EXCL 创建；
+// 续传时要求是 agent 自己创建的普通文件
+func openUploadTemp(path string, offset int64) (*os.File, error) {
+	if offset == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("删除旧的临时文件失败: %v", err)
+		}
+		f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL|syscall.O_NOFOLLOW, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("创建临时文件失败: %v", err)
+		}
+		return f, nil
+	}
+
+	st, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("没有可以续传的临时文件: %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("检查临时文件失败: %v", err)
+	}
+	if err := checkUploadTemp(path, st); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|syscall.O_NOFOLLOW, 0)
+	if err != nil {
+		return nil, fmt.Errorf("打开临时文件失败: %v", err)
+	}
+	// 检查与打开之间文件可能被替换，确认打开的仍是检查过的文件
+	if opened, err := f.Stat(); err != nil || !os.SameFile(st, opened) {
+		f.Close()
+		return nil, fmt.Errorf("临时文件 %s 在打开时被替换", path)
+	}
+	return f, nil
+}
+
+// checkUploadTemp 确认已存在的临时文件是 agent 自己创建的普通文件
+func checkUploadTemp(path string, st os.FileInfo) error {
+	if !st.Mode().IsRegular() {
+		return fmt.Errorf("临时文件 %s 不是普通文件", path)
+	}
+	if sys, ok := st.Sys().(*syscall.Stat_t); ok && int(sys.Uid) != os.Geteuid() {
+		return fmt.Errorf("临时文件 %s 不属于 agent 的运行用户", path)
+	}
+	return nil
+}
+
+// UploadFile 接收分块上传的文件：数据先写入临时文件，校验 SHA-256 后设置权限、
+// 所有者和修改时间，最后重命名为目标文件
+func (s *ResourceCheckerServer) UploadFile(stream pb.ResourceChecker_UploadFileServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "UploadFile")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := first.GetMetadata()
+	if meta == nil {
+		return fmt.Errorf("首条消息必须为 metadata")
+	}
+	audit.Target = meta.Path
+	if !filepath.IsAbs(meta.Path) {
+		return fmt.Errorf("路径必须为绝对路径: %s", meta.Path)
+	}
+
+	tmpPath := uploadTempPath(meta.Path)
+	tmp, err := openUploadTemp(tmpPath, meta.Offset)
+	if err != nil {
+		return err
+	}
+	defer tmp.Close()
+
+	if meta.Offset > 0 {
+		st, err := tmp.Stat()
+		if err != nil {
+			return err
+		}
+		if st.Size() < meta.Offset {
+			return fmt.Errorf("续传偏移 %d 超过已接收的 %d 字节", meta.Offset, st.Size())
+		}
+		if err := tmp.Truncate(meta.Offset); err != nil {
+			return err
+		}
+	}
+	if _, err := tmp.Seek(meta.Offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	var expected string
+	for expected == "" {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return fmt.Errorf("上传未以 sha256 结束，已保留临时文件以便续传")
+		}
+		if err != nil {
+			return err
+		}
+		switch payload := chunk.Payload.(type) {
+		case *pb.FileChunk_Data:
+			if _, err := tmp.Write(payload.Data); err != nil {
+				return fmt.Errorf("写入临时文件失败: %v", err)
+			}
+			audit.OutputSize += int64(len(payload.Data))
+		case *pb.FileChunk_Sha256:
+			expected = payload.Sha256
+		default:
+			return fmt.Errorf("metadata 只能出现在首条消息")
+		}
+	}
+
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	size, sum, err := hashFile(tmp)
+	if err != nil {
+		return err
+	}
+	if meta.Size > 0 && size != meta.Size {
+		os.Remove(tmpPath)
+		return fmt.Errorf("文件大小不一致: 期望 %d，实际 %d", meta.Size, size)
+	}
+	if sum != expected {
+		os.Remove(tmpPath)
+		return fmt.Errorf("SHA-256 校验失败: 期望 %s，实际 %s", expected, sum)
+	}
+
+	if err := applyFileMetadata(tmp, meta); err != nil {
+		return err
+	}
+	// 通过描述符设置时间，按路径设置会跟随关闭后被替换成的符号链接
+	if meta.Mtime > 0 {
+		tv := unix.NsecToTimeval(time.Unix(meta.Mtime, 0).UnixNano())
+		if err := unix.Futimes(int(tmp.Fd()), []unix.Timeval{tv, tv}); err != nil {
+			return fmt.Errorf("设置修改时间失败: %v", err)
+		}
+	}
+	tmp.Close()
+	if err := os.Rename(tmpPath, meta.Path); err != nil {
+		return fmt.Errorf("重命名文件失败: %v", err)
+	}
+	fmt.Printf("上传文件: %s (%d 字节)\n", meta.Path, size)
+	return stream.SendAndClose(&pb.FileTransferResult{Path: meta.Path, Size: size, Sha256: sum})
+}
+
+// applyFileMetadata 设置上传文件的权限和所有者，未指定的属性沿用已存在的目标文件
+func applyFileMetadata(f *os.File, meta *pb.FileMetadata) error {
+	mode := os.FileMode(meta.Mode) & os.ModePerm
+	uid, gid := -1, -1
+	if st, err := os.Stat(meta.Path); err == nil {
+		if mode == 0 {
+			mode = st.Mode().Perm()
+		}
+		if sys, ok := st.Sys().(*syscall.Stat_t); ok {
+			uid, gid = int(sys.Uid), int(sys.Gid)
+		}
+	}
+	if mode == 0 {
+		mode = 0o644
+	}
+	if err := f.Chmod(mode); err != nil {
+		return fmt.Errorf("设置权限失败: %v", err)
+	}
+
+	if meta.Owner != "" {
+		u, err := lookupUser(meta.Owner)
+		if err != nil {
+			return fmt.Errorf("查找用户 %s 失败: %v", meta.Owner, err)
+		}
+		uid, _ = strconv.Atoi(u.Uid)
+		if meta.Group == "" {
+			gid, _ = strconv.Atoi(u.Gid)
+		}
+	}
+	if meta.Group != "" {
+		g, err := lookupGroup(meta.Group)
+		if err != nil {
+			return fmt.Errorf("查找用户组 %s 失败: %v", meta.Group, err)
+		}
+		gid, _ = strconv.Atoi(g.Gid)
+	}
+	if uid != -1 || gid != -1 {
+		if err := f.Chown(uid, gid); err != nil {
+			return fmt.Errorf("设置所有者失败: %v", err)
+		}
+	}
+	return nil
+}
+
+// GetUploadOffset 返回未完成上传已写入临时文件的字节数，没有未完成的上传时为 0
+func (s *ResourceCheckerServer) GetUploadOffset(ctx context.Context, req *pb.FilePathRequest) (resp *pb.UploadOffset, err error) {
+	audit := newAuditEntry(ctx, "GetUploadOffset")
+	audit.Target = req.Path
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	tmpPath := uploadTempPath(req.Path)
+	st, err := os.Lstat(tmpPath)
+	if os.IsNotExist(err) {
+		return &pb.UploadOffset{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUploadTemp(tmpPath, st); err != nil {
+		return nil, err
+	}
+	return &pb.UploadOffset{Offset: st.Size()}, nil
+}
+
+// DownloadFile 分块发送文件，首条消息携带文件属性，最后一条消息携带完整文件的 SHA-256
+func (s *ResourceCheckerServer) DownloadFile(req *pb.DownloadFileRequest, stream pb.ResourceChecker_DownloadFileServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "DownloadFile")
+	audit.Target = req.Path
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return err
+	}
+
+	f, err := openRegularFile(req.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if req.Offset < 0 || req.Offset > st.Size() {
+		return fmt.Errorf("无效的偏移: %d", req.Offset)
+	}
+
+	meta := &pb.FileMetadata{
+		Path:   req.Path,
+		Mode:   uint32(st.Mode().Perm()),
+		Mtime:  st.ModTime().Unix(),
+		Size:   st.Size(),
+		Offset: req.Offset,
+	}
+	if sys, ok := st.Sys().(*syscall.Stat_t); ok {
+		meta.Owner, meta.Group = userName(sys.Uid), groupName(sys.Gid)
+	}
+	if err := stream.Send(&pb.FileChunk{Payload: &pb.FileChunk_Metadata{Metadata: meta}}); err != nil {
+		return err
+	}
+
+	// 续传时跳过的部分也要参与哈希，保证 sha256 针对完整文件
+	hash := sha256.New()
+	if _, err := io.CopyN(hash, f, req.Offset); err != nil {
+		return fmt.Errorf("读取文件失败: %v", err)
+	}
+	buf := make([]byte, fileChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			if err := stream.Send(&pb.FileChunk{Payload: &pb.FileChunk_Data{Data: append([]byte(nil), buf[:n]...)}}); err != nil {
+				return err
+			}
+			audit.OutputSize += int64(n)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("读取文件失败: %v", err)
+		}
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	return stream.Send(&pb.FileChunk{Payload: &pb.FileChunk_Sha256{Sha256: sum}})
+}
+
+// hashFile 计算文件的大小和 SHA-256
+func hashFile(f *os.File) (int64, string, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, "", err
+	}
+	hash := sha256.New()
+	n, err := io.Copy(hash, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// userName 返回 uid 对应的用户名，查不到时返回数字形式
+func userName(uid uint32) string {
+	id := strconv.FormatUint(uint64(uid), 10)
+	if u, err := lookupUser(id); err == nil {
+		return u.Username
+	}
+	return id
+}
+
+// groupName 返回 gid 对应的组名，查不到时返回数字形式
+func groupName(gid uint32) string {
+	id := strconv.FormatUint(uint64(gid), 10)
+	if g, err := lookupGroup(id); err == nil {
+		return g.Name
+	}
+	return id
+}
@@ -0,0 +1,325 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
+	"time"
+
+	pb "server_agent/module/proto"
+)
+
+const (
+	defaultListPageSize = 1000
+	maxReadLength       = 1024 * 1024
+)
+
+// StatPath 返回文件属性，不跟随符号链接
+func (s *ResourceCheckerServer) StatPath(ctx context.Context, req *pb.FilePathRequest) (resp *pb.FileStat, err error) {
+	audit := newAuditEntry(ctx, "StatPath")
+	audit.Target = req.Path
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	info, err := os.Lstat(req.Path)
+	if err != nil {
+		return nil, fmt.Errorf("获取文件属性失败: %v", err)
+	}
+	return newFileStat(req.Path, info), nil
+}
+
+// ListDir 按文件名排序分页列出目录，page_token 为上一页最后一个文件名
+func (s *ResourceCheckerServer) ListDir(ctx context.Context, req *pb.ListDirRequest) (resp *pb.ListDirResponse, err error) {
+	audit := newAuditEntry(ctx, "ListDir")
+	audit.Target = req.Path
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(req.Path)
+	if err != nil {
+		return nil, fmt.Errorf("读取目录失败: %v", err)
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultListPageSize
+	}
+	start := sort.Search(len(entries), func(i int) bool {
+		return entries[i].Name() > req.PageToken
+	})
+
+	resp = &pb.ListDirResponse{}
+	for _, entry := range entries[start:] {
+		if len(resp.Entries) == pageSize {
+			resp.NextPageToken = resp.Entries[len(resp.Entries)-1].Name
+			break
+		}
+		path := filepath.Join(req.Path, entry.Name())
+		info, err := entry.Info()
+		if err != nil {
+			// 列目录和读取属性之间文件可能已被删除
+			continue
+		}
+		resp.Entries = append(resp.Entries, newFileStat(path, info))
+	}
+	return resp, nil
+}
+
+// ReadFileRange 读取文件中指定范围的内容
+func (s *ResourceCheckerServer) ReadFileRange(ctx context.Context, req *pb.ReadFileRangeRequest) (resp *pb.FileContent, err error) {
+	audit := newAuditEntry(ctx, "ReadFileRange")
+	audit.Target = req.Path
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	if req.Offset < 0 {
+		return nil, fmt.Errorf("无效的偏移: %d", req.Offset)
+	}
+	length := req.Length
+	if length <= 0 || length > maxReadLength {
+		length = maxReadLength
+	}
+
+	f, err := openRegularFile(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, length)
+	n, err := f.ReadAt(buf, req.Offset)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("读取文件失败: %v", err)
+	}
+	audit.OutputSize = int64(n)
+	return &pb.FileContent{Data: buf[:n], Offset: req.Offset, Size: info.Size()}, nil
+}
+
+// TailFile 发送文件末尾的若干行；follow 为 true 时每隔一段时间检查文件是否增长，
+// 发现文件被截断或被轮转（路径指向了新文件）时从新文件开头继续读取
+func (s *ResourceCheckerServer) TailFile(req *pb.TailFileRequest, stream pb.ResourceChecker_TailFileServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "TailFile")
+	audit.Target = req.Path
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return err
+	}
+
+	f, err := openRegularFile(req.Path)
+	if err != nil {
+		return err
+	}
+	defer func() { f.Close() }()
+
+	lines := int(req.Lines)
+	if lines <= 0 {
+		lines = 10
+	}
+	offset, err := tailOffset(f, lines)
+	if err != nil {
+		return fmt.Errorf("读取文件失败: %v", err)
+	}
+
+	buf := make([]byte, 64*1024)
+	sendFrom := func() error {
+		for {
+			n, err := f.ReadAt(buf, offset)
+			if n > 0 {
+				info, _ := f.Stat()
+				content := &pb.FileContent{Data: append([]byte(nil), buf[:n]...), Offset: offset}
+				if info != nil {
+					content.Size = info.Size()
+				}
+				if err := stream.Send(content); err != nil {
+					return err
+				}
+				offset += int64(n)
+				audit.OutputSize += int64(n)
+			}
+			if err == io.EOF || n == 0 {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if err := sendFrom(); err != nil {
+		return err
+	}
+	if !req.Follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if info, err := f.Stat(); err == nil && info.Size() < offset {
+			// 文件被截断
+			offset = 0
+		}
+		if rotated(f, req.Path) {
+			// 先读完旧文件剩余的内容再切换到新文件
+			if err := sendFrom(); err != nil {
+				return err
+			}
+			next, err := openRegularFile(req.Path)
+			if err != nil {
+				continue
+			}
+			f.Close()
+			f, offset = next, 0
+		}
+		if err := sendFrom(); err != nil {
+			return err
+		}
+	}
+}
+
+// openRegularFile 以只读方式打开普通文件。FIFO 在没有写入方时会让 open 一直阻塞，
+// 设备文件的读取也可能永不返回，因此先检查类型，并以 O_NONBLOCK 打开后再次确认，
+// 防止检查之后路径被替换
+func openRegularFile(path string) (*os.File, error) {
+	if info, err := os.Stat(path); err == nil && !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("不是普通文件: %s", path)
+	}
+	f, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, fmt.Errorf("打开文件失败: %v", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		f.Close()
+		return nil, fmt.Errorf("不是普通文件: %s", path)
+	}
+	return f, nil
+}
+
+// tailOffset 从文件末尾向前查找，返回倒数第 lines 行的起始偏移
+func tailOffset(f *os.File, lines int) (int64, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	end := info.Size()
+	buf := make([]byte, 8*1024)
+	pos := end
+	count := 0
+	for pos > 0 {
+		n := int64(len(buf))
+		if pos < n {
+			n = pos
+		}
+		pos -= n
+		if _, err := f.ReadAt(buf[:n], pos); err != nil && err != io.EOF {
+			return 0, err
+		}
+		chunk := buf[:n]
+		for i := len(chunk) - 1; i >= 0; i-- {
+			if chunk[i] != '\n' {
+				continue
+			}
+			// 文件末尾的换行不算作新的一行
+			if pos+int64(i) == end-1 {
+				continue
+			}
+			count++
+			if count == lines {
+				return pos + int64(i) + 1, nil
+			}
+		}
+	}
+	return 0, nil
+}
+
+// rotated 判断路径当前指向的文件是否已不是打开的文件
+func rotated(f *os.File, path string) bool {
+	opened, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	current, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return !os.SameFile(opened, current)
+}
+
+// newFileStat 把 os.FileInfo 转换为 FileStat
+func newFileStat(path string, info os.FileInfo) *pb.FileStat {
+	stat := &pb.FileStat{
+		Path:       path,
+		Name:       info.Name(),
+		Type:       fileType(info.Mode()),
+		Size:       info.Size(),
+		Mode:       uint32(info.Mode().Perm()),
+		ModeString: info.Mode().String(),
+		Mtime:      info.ModTime().Unix(),
+	}
+	if sys, ok := info.Sys().(*syscall.Stat_t); ok {
+		stat.Owner, stat.Group = userName(sys.Uid), groupName(sys.Gid)
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		stat.SymlinkTarget, _ = os.Readlink(path)
+	}
+	return stat
+}
+
+func fileType(mode os.FileMode) string {
+	switch {
+	case mode.IsDir():
+		return "dir"
+	case mode&os.ModeSymlink != 0:
+		return "symlink"
+	case mode&os.ModeNamedPipe != 0:
+		return "fifo"
+	case mode&os.ModeSocket != 0:
+		return "socket"
+	case mode&os.ModeCharDevice != 0:
+		return "char_device"
+	case mode&os.ModeDevice != 0:
+		return "block_device"
+	default:
+		return "file"
+	}
+}
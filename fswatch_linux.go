@@ -0,0 +1,237 @@
+//go:build linux
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unsafe"
+
+	pb "server_agent/module/proto"
+
+	"golang.org/x/sys/unix"
+)
+
+const inotifyMask = unix.IN_CREATE | unix.IN_MODIFY | unix.IN_DELETE | unix.IN_DELETE_SELF |
+	unix.IN_MOVED_FROM | unix.IN_MOVED_TO | unix.IN_MOVE_SELF
+
+// pathWatcher 封装一个 inotify 实例，维护监听描述符与路径的对应关系
+type pathWatcher struct {
+	fd        int // 添加监听时直接使用，调用 file.Fd() 会把描述符改回阻塞模式
+	file      *os.File
+	recursive bool
+	paths     map[int32]string
+	roots     map[int32]bool // 请求中直接指定的路径，只有它们需要报告自身被删除或移走
+}
+
+// WatchPath 通过 inotify 推送指定路径下的创建、修改、删除和重命名事件
+func (s *ResourceCheckerServer) WatchPath(req *pb.WatchPathRequest, stream pb.ResourceChecker_WatchPathServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "WatchPath")
+	audit.Target = strings.Join(req.Paths, ",")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return err
+	}
+	if len(req.Paths) == 0 {
+		return fmt.Errorf("至少需要指定一个路径")
+	}
+	for _, pattern := range append(append([]string{}, req.Include...), req.Exclude...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("无效的 glob 模式 %q: %v", pattern, err)
+		}
+	}
+
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return fmt.Errorf("初始化 inotify 失败: %v", err)
+	}
+	// 非阻塞的描述符交给 Go 的 poller 管理，关闭文件即可让阻塞中的 Read 返回
+	w := &pathWatcher{fd: fd, file: os.NewFile(uintptr(fd), "inotify"), recursive: req.Recursive, paths: map[int32]string{}, roots: map[int32]bool{}}
+	defer w.file.Close()
+	go func() {
+		<-ctx.Done()
+		w.file.Close()
+	}()
+
+	for _, path := range req.Paths {
+		if err := w.add(filepath.Clean(path), true); err != nil {
+			return err
+		}
+	}
+
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := w.file.Read(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("读取 inotify 事件失败: %v", err)
+		}
+		for _, event := range w.parse(buf[:n]) {
+			if event.Op != "overflow" && !matchesWatchFilters(event, req.Include, req.Exclude) {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+			audit.OutputSize++
+		}
+	}
+}
+
+// add 监听路径，recursive 时同时监听其下的全部子目录
+func (w *pathWatcher) add(root string, isRoot bool) error {
+	info, err := os.Stat(root)
+	if err != nil {
+		return fmt.Errorf("无法监听 %s: %v", root, err)
+	}
+	wd, err := w.addOne(root)
+	if err != nil {
+		return err
+	}
+	if isRoot {
+		w.roots[wd] = true
+	}
+	if !w.recursive || !info.IsDir() {
+		return nil
+	}
+	return filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			// 子目录在遍历时被删除或没有权限，跳过即可
+			return nil
+		}
+		if d.IsDir() && path != root {
+			_, err = w.addOne(path)
+		}
+		return err
+	})
+}
+
+func (w *pathWatcher) addOne(path string) (int32, error) {
+	wd, err := unix.InotifyAddWatch(w.fd, path, inotifyMask)
+	if err != nil {
+		return 0, fmt.Errorf("监听 %s 失败: %v", path, err)
+	}
+	w.paths[int32(wd)] = path
+	return int32(wd), nil
+}
+
+// parse 把一批 inotify 事件转换为 FileEvent。同一批中 cookie 相同的
+// IN_MOVED_FROM 和 IN_MOVED_TO 合并为 rename，只有一半的移动事件
+// 视为移入（create）或移出（delete）
+func (w *pathWatcher) parse(buf []byte) []*pb.FileEvent {
+	var events []*pb.FileEvent
+	movedFrom := map[uint32]*pb.FileEvent{}
+	now := time.Now().UnixMilli()
+
+	for offset := 0; offset+unix.SizeofInotifyEvent <= len(buf); {
+		raw := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+		nameBytes := buf[offset+unix.SizeofInotifyEvent : offset+unix.SizeofInotifyEvent+int(raw.Len)]
+		offset += unix.SizeofInotifyEvent + int(raw.Len)
+
+		// 内核事件队列已满，之后的事件被丢弃，客户端需要重新扫描监听的路径
+		if raw.Mask&unix.IN_Q_OVERFLOW != 0 {
+			events = append(events, &pb.FileEvent{Op: "overflow", Time: now})
+			continue
+		}
+		if raw.Mask&unix.IN_IGNORED != 0 {
+			delete(w.paths, raw.Wd)
+			delete(w.roots, raw.Wd)
+			continue
+		}
+		// 子目录自身的删除和移动已由父目录的事件报告
+		if raw.Mask&(unix.IN_DELETE_SELF|unix.IN_MOVE_SELF) != 0 && !w.roots[raw.Wd] {
+			continue
+		}
+		dir, ok := w.paths[raw.Wd]
+		if !ok {
+			continue
+		}
+		path := dir
+		if name := string(bytes.TrimRight(nameBytes, "\x00")); name != "" {
+			path = filepath.Join(dir, name)
+		}
+		event := &pb.FileEvent{Path: path, IsDir: raw.Mask&unix.IN_ISDIR != 0, Time: now}
+
+		switch {
+		case raw.Mask&unix.IN_CREATE != 0:
+			event.Op = "create"
+			if event.IsDir && w.recursive {
+				w.add(path, false)
+			}
+		case raw.Mask&unix.IN_MODIFY != 0:
+			event.Op = "modify"
+		case raw.Mask&(unix.IN_DELETE|unix.IN_DELETE_SELF) != 0:
+			event.Op = "delete"
+		case raw.Mask&unix.IN_MOVED_FROM != 0:
+			event.Op = "delete"
+			movedFrom[raw.Cookie] = event
+		case raw.Mask&unix.IN_MOVED_TO != 0:
+			event.Op = "create"
+			if from, ok := movedFrom[raw.Cookie]; ok {
+				// 把移出事件改写为重命名，不再单独推送移入事件
+				from.Op, from.OldPath, from.Path = "rename", from.Path, path
+				delete(movedFrom, raw.Cookie)
+				if event.IsDir {
+					w.renamePrefix(from.OldPath, path)
+				}
+				continue
+			}
+			if event.IsDir && w.recursive {
+				w.add(path, false)
+			}
+		case raw.Mask&unix.IN_MOVE_SELF != 0:
+			// 被监听的路径自身被移走，inotify 无法给出新路径
+			event.Op = "rename"
+			event.OldPath, event.Path = path, ""
+		default:
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// renamePrefix 目录被重命名后更新其下全部监听路径
+func (w *pathWatcher) renamePrefix(oldPath, newPath string) {
+	for wd, path := range w.paths {
+		if path == oldPath {
+			w.paths[wd] = newPath
+		} else if strings.HasPrefix(path, oldPath+"/") {
+			w.paths[wd] = newPath + strings.TrimPrefix(path, oldPath)
+		}
+	}
+}
+
+// matchesWatchFilters 按 include、exclude 过滤事件，模式同时与文件名和完整路径比较
+func matchesWatchFilters(event *pb.FileEvent, include, exclude []string) bool {
+	path := event.Path
+	if path == "" {
+		path = event.OldPath
+	}
+	matches := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := filepath.Match(pattern, filepath.Base(path)); ok {
+				return true
+			}
+			if ok, _ := filepath.Match(pattern, path); ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(include) > 0 && !matches(include) {
+		return false
+	}
+	return !matches(exclude)
+}
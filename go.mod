@@ -5,8 +5,10 @@ go 1.22.7
 toolchain go1.22.9
 
 require (
+	github.com/creack/pty v1.1.24
 	github.com/docker/docker v23.0.3+incompatible
 	github.com/shirou/gopsutil v3.21.11+incompatible
+	golang.org/x/sys v0.27.0
 	google.golang.org/grpc v1.68.0
 	google.golang.org/protobuf v1.35.1
 )
@@ -29,7 +31,6 @@ require (
 	github.com/tklauser/numcpus v0.8.0 // indirect
 	github.com/yusufpapurcu/wmi v1.2.4 // indirect
 	golang.org/x/net v0.30.0 // indirect
-	golang.org/x/sys v0.27.0 // indirect
 	golang.org/x/text v0.20.0 // indirect
 	golang.org/x/time v0.8.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241104194629-dd2ea8efbc28 // indirect
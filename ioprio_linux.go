@@ -0,0 +1,53 @@
+//go:build linux
+
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// ioprio 的编码见 linux/ioprio.h：高 3 位为调度类，低 13 位为级别
+const (
+	ioprioClassShift = 13
+	ioprioWhoProcess = 1
+)
+
+var ioprioClasses = []string{"none", "realtime", "best-effort", "idle"}
+
+// getIOPriority 返回进程的 IO 调度类和级别
+func getIOPriority(pid int32) (string, int32, error) {
+	prio, _, errno := unix.Syscall(unix.SYS_IOPRIO_GET, ioprioWhoProcess, uintptr(pid), 0)
+	if errno != 0 {
+		return "", 0, errno
+	}
+	class := int(prio >> ioprioClassShift)
+	if class >= len(ioprioClasses) {
+		return "", 0, fmt.Errorf("未知的 IO 调度类: %d", class)
+	}
+	return ioprioClasses[class], int32(prio & (1<<ioprioClassShift - 1)), nil
+}
+
+// setIOPriority 设置进程的 IO 调度类和级别，idle 和 none 忽略级别
+func setIOPriority(pid int32, class string, level int32) error {
+	classID := -1
+	for i, name := range ioprioClasses {
+		if name == class {
+			classID = i
+		}
+	}
+	if classID < 0 {
+		return fmt.Errorf("不支持的 IO 调度类: %s", class)
+	}
+	if class == "idle" || class == "none" {
+		level = 0
+	} else if level < 0 || level > 7 {
+		return fmt.Errorf("IO 优先级必须在 0 到 7 之间: %d", level)
+	}
+	prio := uintptr(classID)<<ioprioClassShift | uintptr(level)
+	if _, _, errno := unix.Syscall(unix.SYS_IOPRIO_SET, ioprioWhoProcess, uintptr(pid), prio); errno != 0 {
+		return fmt.Errorf("设置 IO 优先级失败: %v", errno)
+	}
+	return nil
+}
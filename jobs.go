@@ -0,0 +1,422 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	pb "server_agent/module/proto"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// 任务状态
+const (
+	jobRunning   = "running"
+	jobSucceeded = "succeeded"
+	jobFailed    = "failed"
+	jobCanceled  = "canceled"
+	jobTimedOut  = "timed_out"
+	jobLost      = "lost" // agent 重启时仍在运行，无法得知结果
+)
+
+// job 是一个在后台执行的命令，输出直接写入任务目录下的 stdout、stderr 文件，
+// 即使 agent 重启，进程仍会继续写入
+type job struct {
+	dir    string
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu   sync.Mutex
+	info *pb.JobInfo
+}
+
+// jobManager 管理后台任务，结束的任务在保留期过后连同输出一起删除
+type jobManager struct {
+	dir       string
+	retention time.Duration
+
+	mu   sync.Mutex
+	jobs map[string]*job
+}
+
+var jobs *jobManager
+
+// newJobManager 创建任务目录并加载上次运行留下的任务记录
+func newJobManager(dir string, retention time.Duration) (*jobManager, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("创建任务目录失败: %v", err)
+	}
+	m := &jobManager{dir: dir, retention: retention, jobs: map[string]*job{}}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("读取任务目录失败: %v", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		j := &job{dir: filepath.Join(dir, entry.Name()), done: make(chan struct{})}
+		data, err := os.ReadFile(filepath.Join(j.dir, "job.json"))
+		if err != nil {
+			continue
+		}
+		info := &pb.JobInfo{}
+		if err := protojson.Unmarshal(data, info); err != nil {
+			log.Printf("解析任务 %s 失败: %v", entry.Name(), err)
+			continue
+		}
+		if info.State == jobRunning {
+			info.State = jobLost
+			info.FinishedAt = time.Now().Unix()
+		}
+		close(j.done)
+		j.info = info
+		j.save()
+		m.jobs[info.JobId] = j
+	}
+
+	go m.cleanup()
+	return m, nil
+}
+
+// submit 启动后台任务。命令与 RunShell 走相同的策略和运行身份检查，
+// 但不随请求上下文取消，只受 timeout_seconds 和 CancelJob 控制
+func (m *jobManager) submit(ctx context.Context, req *pb.ShellRequest) (*pb.JobInfo, error) {
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+	cmdCtx, cancel := shellContext(context.WithoutCancel(ctx), req)
+	cmd, err := newShellCommand(cmdCtx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	j := &job{dir: filepath.Join(m.dir, id), cancel: cancel, done: make(chan struct{})}
+	if err := os.Mkdir(j.dir, 0o700); err != nil {
+		cancel()
+		return nil, fmt.Errorf("创建任务目录失败: %v", err)
+	}
+	stdout, err := os.Create(filepath.Join(j.dir, "stdout"))
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("创建输出文件失败: %v", err)
+	}
+	defer stdout.Close()
+	stderr, err := os.Create(filepath.Join(j.dir, "stderr"))
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("创建输出文件失败: %v", err)
+	}
+	defer stderr.Close()
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+
+	j.info = &pb.JobInfo{
+		JobId:     id,
+		Command:   req.Command,
+		State:     jobRunning,
+		CreatedAt: time.Now().Unix(),
+		RunAsUser: req.RunAsUser,
+		Owner:     tokenFingerprint(requestToken(ctx)),
+	}
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		cancel()
+		os.RemoveAll(j.dir)
+		return nil, fmt.Errorf("启动任务失败: %v", err)
+	}
+	fmt.Printf("提交任务 %s: %s\n", id, req.Command)
+	j.save()
+
+	m.mu.Lock()
+	m.jobs[id] = j
+	m.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		waitErr := cmd.Wait()
+		exit := finishShell(cmdCtx, cmd, waitErr, start)
+
+		j.mu.Lock()
+		j.info.Exit = exit
+		j.info.FinishedAt = time.Now().Unix()
+		switch {
+		case exit.TimedOut:
+			j.info.State = jobTimedOut
+		case errors.Is(cmdCtx.Err(), context.Canceled):
+			j.info.State = jobCanceled
+		case exit.ExitCode == 0:
+			j.info.State = jobSucceeded
+		default:
+			j.info.State = jobFailed
+		}
+		j.mu.Unlock()
+		j.save()
+		close(j.done)
+	}()
+
+	return j.snapshot(), nil
+}
+
+// get 查找任务，令牌只能访问自己提交的任务，允许 root 的令牌可以访问全部任务
+func (m *jobManager) get(ctx context.Context, id string) (*job, error) {
+	m.mu.Lock()
+	j, ok := m.jobs[id]
+	m.mu.Unlock()
+	if !ok || !canAccessJob(ctx, j) {
+		return nil, fmt.Errorf("任务不存在: %s", id)
+	}
+	return j, nil
+}
+
+func (m *jobManager) list(ctx context.Context) []*pb.JobInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var infos []*pb.JobInfo
+	for _, j := range m.jobs {
+		if canAccessJob(ctx, j) {
+			infos = append(infos, j.snapshot())
+		}
+	}
+	sort.Slice(infos, func(a, b int) bool {
+		return infos[a].CreatedAt > infos[b].CreatedAt
+	})
+	return infos
+}
+
+func canAccessJob(ctx context.Context, j *job) bool {
+	token := requestToken(ctx)
+	if tokenAllowsRoot(token) {
+		return true
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.info.Owner == tokenFingerprint(token)
+}
+
+// cleanup 定期删除超过保留期的已结束任务
+func (m *jobManager) cleanup() {
+	for range time.Tick(time.Minute) {
+		deadline := time.Now().Add(-m.retention).Unix()
+		m.mu.Lock()
+		for id, j := range m.jobs {
+			info := j.snapshot()
+			if info.State != jobRunning && info.FinishedAt < deadline {
+				os.RemoveAll(j.dir)
+				delete(m.jobs, id)
+			}
+		}
+		m.mu.Unlock()
+	}
+}
+
+// snapshot 返回任务信息的副本，并补上当前的输出大小
+func (j *job) snapshot() *pb.JobInfo {
+	j.mu.Lock()
+	info := proto.Clone(j.info).(*pb.JobInfo)
+	j.mu.Unlock()
+	if st, err := os.Stat(filepath.Join(j.dir, "stdout")); err == nil {
+		info.StdoutSize = st.Size()
+	}
+	if st, err := os.Stat(filepath.Join(j.dir, "stderr")); err == nil {
+		info.StderrSize = st.Size()
+	}
+	return info
+}
+
+func (j *job) finished() bool {
+	select {
+	case <-j.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// save 把任务信息写入 job.json，先写临时文件再重命名，避免留下不完整的记录
+func (j *job) save() {
+	data, err := protojson.Marshal(j.snapshot())
+	if err != nil {
+		log.Printf("序列化任务信息失败: %v", err)
+		return
+	}
+	tmp := filepath.Join(j.dir, "job.json.tmp")
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		log.Printf("保存任务信息失败: %v", err)
+		return
+	}
+	if err := os.Rename(tmp, filepath.Join(j.dir, "job.json")); err != nil {
+		log.Printf("保存任务信息失败: %v", err)
+	}
+}
+
+func newJobID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("生成任务 ID 失败: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// SubmitJob 在后台执行命令并立即返回任务 ID
+func (s *ResourceCheckerServer) SubmitJob(ctx context.Context, req *pb.ShellRequest) (info *pb.JobInfo, err error) {
+	audit := newAuditEntry(ctx, "SubmitJob")
+	audit.Command, audit.RunAsUser = req.Command, req.RunAsUser
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	return jobs.submit(ctx, req)
+}
+
+// GetJob 查询任务状态
+func (s *ResourceCheckerServer) GetJob(ctx context.Context, req *pb.JobRequest) (info *pb.JobInfo, err error) {
+	audit := newAuditEntry(ctx, "GetJob")
+	audit.Target = req.JobId
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	j, err := jobs.get(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return j.snapshot(), nil
+}
+
+// ListJobs 列出调用方可见的任务，按提交时间倒序
+func (s *ResourceCheckerServer) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (resp *pb.ListJobsResponse, err error) {
+	audit := newAuditEntry(ctx, "ListJobs")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.ListJobsResponse{Jobs: jobs.list(ctx)}, nil
+}
+
+// CancelJob 终止运行中的任务，已结束的任务不受影响
+func (s *ResourceCheckerServer) CancelJob(ctx context.Context, req *pb.JobRequest) (info *pb.JobInfo, err error) {
+	audit := newAuditEntry(ctx, "CancelJob")
+	audit.Target = req.JobId
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	j, err := jobs.get(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if j.cancel != nil && !j.finished() {
+		j.cancel()
+		select {
+		case <-j.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return j.snapshot(), nil
+}
+
+// StreamJobOutput 从指定偏移推送任务输出。follow 为 true 时持续推送直到任务结束，
+// 任务已结束时最后一条消息携带退出信息
+func (s *ResourceCheckerServer) StreamJobOutput(req *pb.JobOutputRequest, stream pb.ResourceChecker_StreamJobOutputServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "StreamJobOutput")
+	audit.Target = req.JobId
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
+	}
+	j, err := jobs.get(ctx, req.JobId)
+	if err != nil {
+		return err
+	}
+
+	stdout, err := os.Open(filepath.Join(j.dir, "stdout"))
+	if err != nil {
+		return fmt.Errorf("打开任务输出失败: %v", err)
+	}
+	defer stdout.Close()
+	stderr, err := os.Open(filepath.Join(j.dir, "stderr"))
+	if err != nil {
+		return fmt.Errorf("打开任务输出失败: %v", err)
+	}
+	defer stderr.Close()
+	if _, err := stdout.Seek(req.StdoutOffset, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := stderr.Seek(req.StderrOffset, io.SeekStart); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 32*1024)
+	drain := func(f *os.File, wrap func([]byte) *pb.ShellChunk) error {
+		for {
+			n, err := f.Read(buf)
+			if n > 0 {
+				if err := stream.Send(wrap(append([]byte(nil), buf[:n]...))); err != nil {
+					return err
+				}
+				audit.OutputSize += int64(n)
+			}
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	drainAll := func() error {
+		if err := drain(stdout, func(b []byte) *pb.ShellChunk { return &pb.ShellChunk{Payload: &pb.ShellChunk_Stdout{Stdout: b}} }); err != nil {
+			return err
+		}
+		return drain(stderr, func(b []byte) *pb.ShellChunk { return &pb.ShellChunk{Payload: &pb.ShellChunk_Stderr{Stderr: b}} })
+	}
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		// 先判断是否结束再读取，保证结束前写入的输出都能读到
+		finished := j.finished()
+		if err := drainAll(); err != nil {
+			return err
+		}
+		if finished {
+			exit := j.snapshot().Exit
+			if exit == nil {
+				exit = &pb.ShellExit{ExitCode: -1, Error: "任务结果未知"}
+			}
+			return stream.Send(&pb.ShellChunk{Payload: &pb.ShellChunk_Exit{Exit: exit}})
+		}
+		if !req.Follow {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-j.done:
+		case <-ticker.C:
+		}
+	}
+}
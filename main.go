@@ -1,3 +1,6 @@
+//go:build linux
+
+// agent 依赖 /proc、伪终端、inotify、ioprio 等 Linux 特有的接口，只支持在 Linux 上构建和运行
 package main
 
 import (
@@ -9,13 +12,13 @@ import (
 	"log"
 	"net"
 	"os"
-	"os/exec"
 	"time"
 
 	pb "server_agent/module/proto" // 替换为实际 proto 包路径
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/proto"
 )
 
 // ResourceCheckerServer 定义服务
@@ -25,27 +28,59 @@ type ResourceCheckerServer struct {
 
 var serverStartTime = time.Now()
 
-// CheckResources 实现资源检查逻辑
-func (s *ResourceCheckerServer) CheckResources(ctx context.Context, req *pb.ResourceRequest) (*pb.ResourceResponse, error) {
+// CheckResources 实现资源检查逻辑，直接返回后台采样器的最新快照
+func (s *ResourceCheckerServer) CheckResources(ctx context.Context, req *pb.ResourceRequest) (resp *pb.ResourceResponse, err error) {
+	audit := newAuditEntry(ctx, "CheckResources")
+	defer func() { audit.commit(err) }()
+
 	if err := AuthInterceptor(ctx); err != nil {
 		return nil, err
 	}
+	resp, err = sampler.Latest(ctx)
+	audit.OutputSize = int64(proto.Size(resp))
+	return resp, err
+}
 
-	// 获取系统信息
-	data, err := CheckResources()
-	if err != nil {
-		return nil, err
+// WatchResources 按客户端指定的间隔持续推送后台采样器的最新快照，直到客户端取消
+func (s *ResourceCheckerServer) WatchResources(req *pb.WatchResourcesRequest, stream pb.ResourceChecker_WatchResourcesServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "WatchResources")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
 	}
 
-	// 获取网络接口信息
-	ipAddresses, err := GetIPAddresses()
-	if err != nil {
-		ipAddresses = []string{"无法获取 IP"}
+	interval := time.Duration(req.IntervalMs) * time.Millisecond
+	if interval < sampler.interval {
+		interval = sampler.interval
 	}
 
-	// 获取 Docker 信息
-	containers, dockerAvailable := GetDockerInfo()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		resp, err := sampler.Latest(ctx)
+		if err != nil {
+			return err
+		}
+		if !req.IncludeContainers {
+			resp = withoutContainers(resp)
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		audit.OutputSize += int64(proto.Size(resp))
 
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// newResourceResponse 将资源数据转换为响应消息
+func newResourceResponse(data map[string]interface{}) *pb.ResourceResponse {
 	return &pb.ResourceResponse{
 		Hostname:          data["hostname"].(string),
 		Os:                data["os"].(string),
@@ -63,25 +98,7 @@ func (s *ResourceCheckerServer) CheckResources(ctx context.Context, req *pb.Reso
 		MemoryTotal:       data["memory_total"].(float64),
 		UptimeDays:        data["uptime_days"].(float64),
 		WebshellSupported: data["webshell_supported"].(bool),
-		IpAddresses:       ipAddresses,
-		DockerAvailable:   dockerAvailable,
-		Containers:        containers,
-	}, nil
-}
-
-// RunShell 执行客户端发来的 Shell 命令
-func (s *ResourceCheckerServer) RunShell(ctx context.Context, req *pb.ShellRequest) (*pb.ShellResponse, error) {
-	if err := AuthInterceptor(ctx); err != nil {
-		return nil, err
 	}
-	cmd := exec.Command("bash", "-c", req.Command)
-	cmd.Args = []string{"bash", "-c", req.Command}
-	fmt.Printf("执行命令: %s\n", req.Command)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return &pb.ShellResponse{Output: string(output), Error: err.Error()}, nil
-	}
-	return &pb.ShellResponse{Output: string(output)}, nil
 }
 
 func main() {
@@ -89,6 +106,43 @@ func main() {
 	if os.Getenv("AUTH_TOKEN") == "" {
 		log.Fatalf("缺少环境变量: AUTH_TOKEN")
 	}
+	// 加载命令策略
+	if file := os.Getenv("POLICY_FILE"); file != "" {
+		// 未设置 ROOT_TOKENS 时所有令牌都有 root 权限，可以通过上传文件、进入容器等接口绕过策略，
+		// 甚至直接改写策略文件
+		if len(rootTokens()) == 0 {
+			log.Fatalf("设置 POLICY_FILE 时必须同时设置 ROOT_TOKENS，否则命令策略可以被任意令牌绕过")
+		}
+		p, err := loadPolicy(file)
+		if err != nil {
+			log.Fatalf("加载命令策略失败: %v", err)
+		}
+		policy = p
+	}
+	// 打开审计日志
+	auditPath := os.Getenv("AUDIT_LOG")
+	if auditPath == "" {
+		auditPath = "audit.log"
+	}
+	a, err := openAuditLog(auditPath)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	auditor = a
+	// 初始化后台任务
+	jobDir := os.Getenv("JOB_DIR")
+	if jobDir == "" {
+		jobDir = "jobs"
+	}
+	jobRetention := 24 * time.Hour
+	if v := os.Getenv("JOB_RETENTION"); v != "" {
+		if jobRetention, err = time.ParseDuration(v); err != nil {
+			log.Fatalf("无效的任务保留期 JOB_RETENTION: %q", v)
+		}
+	}
+	if jobs, err = newJobManager(jobDir, jobRetention); err != nil {
+		log.Fatalf("%v", err)
+	}
 	// 加载 TLS 配置
 	cert, err := tls.LoadX509KeyPair("server.crt", "server.key")
 	if err != nil {
@@ -103,8 +157,34 @@ func main() {
 	creds := credentials.NewTLS(&tls.Config{
 		Certificates: []tls.Certificate{cert},
 		ClientCAs:    certPool,
+		// 客户端提供证书时校验并记录到审计日志，不强制要求
+		ClientAuth: tls.VerifyClientCertIfGiven,
 	})
 
+	// 启动后台资源采样
+	if v := os.Getenv("SAMPLE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			log.Fatalf("无效的采样周期 SAMPLE_INTERVAL: %q", v)
+		}
+		sampler.interval = interval
+	}
+	// 不需要上报的文件系统类型和挂载点（逗号分隔，挂载点支持 glob），设置后替换默认列表
+	if v, ok := os.LookupEnv("FS_EXCLUDE_TYPES"); ok {
+		fsFilter.excludeTypes = splitList(v)
+	}
+	if v, ok := os.LookupEnv("FS_EXCLUDE_MOUNTS"); ok {
+		fsFilter.excludeMounts = splitList(v)
+	}
+	// 参与统计的网卡（逗号分隔的 glob），NET_EXCLUDE_IFACES 设置后替换默认列表
+	if v := os.Getenv("NET_INCLUDE_IFACES"); v != "" {
+		ifaceFilter.include = splitList(v)
+	}
+	if v, ok := os.LookupEnv("NET_EXCLUDE_IFACES"); ok {
+		ifaceFilter.exclude = splitList(v)
+	}
+	sampler.Start()
+
 	// 启动 gRPC 服务
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
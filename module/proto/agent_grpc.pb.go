@@ -19,8 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ResourceChecker_CheckResources_FullMethodName = "/agent.ResourceChecker/CheckResources"
-	ResourceChecker_RunShell_FullMethodName       = "/agent.ResourceChecker/RunShell"
+	ResourceChecker_CheckResources_FullMethodName     = "/agent.ResourceChecker/CheckResources"
+	ResourceChecker_RunShell_FullMethodName           = "/agent.ResourceChecker/RunShell"
+	ResourceChecker_WatchResources_FullMethodName     = "/agent.ResourceChecker/WatchResources"
+	ResourceChecker_RunShellStream_FullMethodName     = "/agent.ResourceChecker/RunShellStream"
+	ResourceChecker_OpenShell_FullMethodName          = "/agent.ResourceChecker/OpenShell"
+	ResourceChecker_SubmitJob_FullMethodName          = "/agent.ResourceChecker/SubmitJob"
+	ResourceChecker_GetJob_FullMethodName             = "/agent.ResourceChecker/GetJob"
+	ResourceChecker_ListJobs_FullMethodName           = "/agent.ResourceChecker/ListJobs"
+	ResourceChecker_CancelJob_FullMethodName          = "/agent.ResourceChecker/CancelJob"
+	ResourceChecker_StreamJobOutput_FullMethodName    = "/agent.ResourceChecker/StreamJobOutput"
+	ResourceChecker_UploadFile_FullMethodName         = "/agent.ResourceChecker/UploadFile"
+	ResourceChecker_GetUploadOffset_FullMethodName    = "/agent.ResourceChecker/GetUploadOffset"
+	ResourceChecker_DownloadFile_FullMethodName       = "/agent.ResourceChecker/DownloadFile"
+	ResourceChecker_StatPath_FullMethodName           = "/agent.ResourceChecker/StatPath"
+	ResourceChecker_ListDir_FullMethodName            = "/agent.ResourceChecker/ListDir"
+	ResourceChecker_ReadFileRange_FullMethodName      = "/agent.ResourceChecker/ReadFileRange"
+	ResourceChecker_TailFile_FullMethodName           = "/agent.ResourceChecker/TailFile"
+	ResourceChecker_WatchPath_FullMethodName          = "/agent.ResourceChecker/WatchPath"
+	ResourceChecker_ListProcesses_FullMethodName      = "/agent.ResourceChecker/ListProcesses"
+	ResourceChecker_GetProcess_FullMethodName         = "/agent.ResourceChecker/GetProcess"
+	ResourceChecker_SignalProcess_FullMethodName      = "/agent.ResourceChecker/SignalProcess"
+	ResourceChecker_SetProcessPriority_FullMethodName = "/agent.ResourceChecker/SetProcessPriority"
+	ResourceChecker_ListUnits_FullMethodName          = "/agent.ResourceChecker/ListUnits"
+	ResourceChecker_GetUnitStatus_FullMethodName      = "/agent.ResourceChecker/GetUnitStatus"
+	ResourceChecker_ControlUnit_FullMethodName        = "/agent.ResourceChecker/ControlUnit"
+	ResourceChecker_GetUnitJournal_FullMethodName     = "/agent.ResourceChecker/GetUnitJournal"
+	ResourceChecker_StartContainer_FullMethodName     = "/agent.ResourceChecker/StartContainer"
+	ResourceChecker_StopContainer_FullMethodName      = "/agent.ResourceChecker/StopContainer"
+	ResourceChecker_RestartContainer_FullMethodName   = "/agent.ResourceChecker/RestartContainer"
+	ResourceChecker_PauseContainer_FullMethodName     = "/agent.ResourceChecker/PauseContainer"
+	ResourceChecker_UnpauseContainer_FullMethodName   = "/agent.ResourceChecker/UnpauseContainer"
+	ResourceChecker_KillContainer_FullMethodName      = "/agent.ResourceChecker/KillContainer"
+	ResourceChecker_RemoveContainer_FullMethodName    = "/agent.ResourceChecker/RemoveContainer"
+	ResourceChecker_ContainerLogs_FullMethodName      = "/agent.ResourceChecker/ContainerLogs"
+	ResourceChecker_ContainerExec_FullMethodName      = "/agent.ResourceChecker/ContainerExec"
 )
 
 // ResourceCheckerClient is the client API for ResourceChecker service.
@@ -29,6 +62,39 @@ const (
 type ResourceCheckerClient interface {
 	CheckResources(ctx context.Context, in *ResourceRequest, opts ...grpc.CallOption) (*ResourceResponse, error)
 	RunShell(ctx context.Context, in *ShellRequest, opts ...grpc.CallOption) (*ShellResponse, error)
+	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResourceResponse], error)
+	RunShellStream(ctx context.Context, in *ShellRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ShellChunk], error)
+	OpenShell(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ShellInput, ShellOutput], error)
+	SubmitJob(ctx context.Context, in *ShellRequest, opts ...grpc.CallOption) (*JobInfo, error)
+	GetJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobInfo, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobInfo, error)
+	StreamJobOutput(ctx context.Context, in *JobOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ShellChunk], error)
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[FileChunk, FileTransferResult], error)
+	GetUploadOffset(ctx context.Context, in *FilePathRequest, opts ...grpc.CallOption) (*UploadOffset, error)
+	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error)
+	StatPath(ctx context.Context, in *FilePathRequest, opts ...grpc.CallOption) (*FileStat, error)
+	ListDir(ctx context.Context, in *ListDirRequest, opts ...grpc.CallOption) (*ListDirResponse, error)
+	ReadFileRange(ctx context.Context, in *ReadFileRangeRequest, opts ...grpc.CallOption) (*FileContent, error)
+	TailFile(ctx context.Context, in *TailFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileContent], error)
+	WatchPath(ctx context.Context, in *WatchPathRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileEvent], error)
+	ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error)
+	GetProcess(ctx context.Context, in *ProcessRequest, opts ...grpc.CallOption) (*ProcessInfo, error)
+	SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*SignalProcessResponse, error)
+	SetProcessPriority(ctx context.Context, in *SetProcessPriorityRequest, opts ...grpc.CallOption) (*ProcessInfo, error)
+	ListUnits(ctx context.Context, in *ListUnitsRequest, opts ...grpc.CallOption) (*ListUnitsResponse, error)
+	GetUnitStatus(ctx context.Context, in *UnitRequest, opts ...grpc.CallOption) (*UnitStatus, error)
+	ControlUnit(ctx context.Context, in *UnitActionRequest, opts ...grpc.CallOption) (*UnitStatus, error)
+	GetUnitJournal(ctx context.Context, in *UnitJournalRequest, opts ...grpc.CallOption) (*UnitJournalResponse, error)
+	StartContainer(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error)
+	StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error)
+	RestartContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error)
+	PauseContainer(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error)
+	UnpauseContainer(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error)
+	KillContainer(ctx context.Context, in *KillContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error)
+	RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error)
+	ContainerLogs(ctx context.Context, in *ContainerLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContainerLogChunk], error)
+	ContainerExec(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ContainerExecInput, ShellChunk], error)
 }
 
 type resourceCheckerClient struct {
@@ -59,85 +125,1131 @@ func (c *resourceCheckerClient) RunShell(ctx context.Context, in *ShellRequest,
 	return out, nil
 }
 
+func (c *resourceCheckerClient) WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[0], ResourceChecker_WatchResources_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchResourcesRequest, ResourceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_WatchResourcesClient = grpc.ServerStreamingClient[ResourceResponse]
+
+func (c *resourceCheckerClient) RunShellStream(ctx context.Context, in *ShellRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ShellChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[1], ResourceChecker_RunShellStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ShellRequest, ShellChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_RunShellStreamClient = grpc.ServerStreamingClient[ShellChunk]
+
+func (c *resourceCheckerClient) OpenShell(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ShellInput, ShellOutput], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[2], ResourceChecker_OpenShell_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ShellInput, ShellOutput]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_OpenShellClient = grpc.BidiStreamingClient[ShellInput, ShellOutput]
+
+func (c *resourceCheckerClient) SubmitJob(ctx context.Context, in *ShellRequest, opts ...grpc.CallOption) (*JobInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobInfo)
+	err := c.cc.Invoke(ctx, ResourceChecker_SubmitJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) GetJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobInfo)
+	err := c.cc.Invoke(ctx, ResourceChecker_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobInfo)
+	err := c.cc.Invoke(ctx, ResourceChecker_CancelJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) StreamJobOutput(ctx context.Context, in *JobOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ShellChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[3], ResourceChecker_StreamJobOutput_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[JobOutputRequest, ShellChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_StreamJobOutputClient = grpc.ServerStreamingClient[ShellChunk]
+
+func (c *resourceCheckerClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[FileChunk, FileTransferResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[4], ResourceChecker_UploadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FileChunk, FileTransferResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_UploadFileClient = grpc.ClientStreamingClient[FileChunk, FileTransferResult]
+
+func (c *resourceCheckerClient) GetUploadOffset(ctx context.Context, in *FilePathRequest, opts ...grpc.CallOption) (*UploadOffset, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadOffset)
+	err := c.cc.Invoke(ctx, ResourceChecker_GetUploadOffset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[5], ResourceChecker_DownloadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadFileRequest, FileChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_DownloadFileClient = grpc.ServerStreamingClient[FileChunk]
+
+func (c *resourceCheckerClient) StatPath(ctx context.Context, in *FilePathRequest, opts ...grpc.CallOption) (*FileStat, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileStat)
+	err := c.cc.Invoke(ctx, ResourceChecker_StatPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) ListDir(ctx context.Context, in *ListDirRequest, opts ...grpc.CallOption) (*ListDirResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDirResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_ListDir_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) ReadFileRange(ctx context.Context, in *ReadFileRangeRequest, opts ...grpc.CallOption) (*FileContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileContent)
+	err := c.cc.Invoke(ctx, ResourceChecker_ReadFileRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) TailFile(ctx context.Context, in *TailFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileContent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[6], ResourceChecker_TailFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailFileRequest, FileContent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_TailFileClient = grpc.ServerStreamingClient[FileContent]
+
+func (c *resourceCheckerClient) WatchPath(ctx context.Context, in *WatchPathRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[7], ResourceChecker_WatchPath_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPathRequest, FileEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_WatchPathClient = grpc.ServerStreamingClient[FileEvent]
+
+func (c *resourceCheckerClient) ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProcessesResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_ListProcesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) GetProcess(ctx context.Context, in *ProcessRequest, opts ...grpc.CallOption) (*ProcessInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessInfo)
+	err := c.cc.Invoke(ctx, ResourceChecker_GetProcess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*SignalProcessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignalProcessResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_SignalProcess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) SetProcessPriority(ctx context.Context, in *SetProcessPriorityRequest, opts ...grpc.CallOption) (*ProcessInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessInfo)
+	err := c.cc.Invoke(ctx, ResourceChecker_SetProcessPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) ListUnits(ctx context.Context, in *ListUnitsRequest, opts ...grpc.CallOption) (*ListUnitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnitsResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_ListUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) GetUnitStatus(ctx context.Context, in *UnitRequest, opts ...grpc.CallOption) (*UnitStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnitStatus)
+	err := c.cc.Invoke(ctx, ResourceChecker_GetUnitStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) ControlUnit(ctx context.Context, in *UnitActionRequest, opts ...grpc.CallOption) (*UnitStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnitStatus)
+	err := c.cc.Invoke(ctx, ResourceChecker_ControlUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) GetUnitJournal(ctx context.Context, in *UnitJournalRequest, opts ...grpc.CallOption) (*UnitJournalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnitJournalResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_GetUnitJournal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) StartContainer(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContainerActionResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_StartContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContainerActionResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_StopContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) RestartContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContainerActionResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_RestartContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) PauseContainer(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContainerActionResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_PauseContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) UnpauseContainer(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContainerActionResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_UnpauseContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) KillContainer(ctx context.Context, in *KillContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContainerActionResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_KillContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*ContainerActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContainerActionResponse)
+	err := c.cc.Invoke(ctx, ResourceChecker_RemoveContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceCheckerClient) ContainerLogs(ctx context.Context, in *ContainerLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContainerLogChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[8], ResourceChecker_ContainerLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ContainerLogsRequest, ContainerLogChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_ContainerLogsClient = grpc.ServerStreamingClient[ContainerLogChunk]
+
+func (c *resourceCheckerClient) ContainerExec(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ContainerExecInput, ShellChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceChecker_ServiceDesc.Streams[9], ResourceChecker_ContainerExec_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ContainerExecInput, ShellChunk]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_ContainerExecClient = grpc.BidiStreamingClient[ContainerExecInput, ShellChunk]
+
 // ResourceCheckerServer is the server API for ResourceChecker service.
 // All implementations must embed UnimplementedResourceCheckerServer
 // for forward compatibility.
 type ResourceCheckerServer interface {
 	CheckResources(context.Context, *ResourceRequest) (*ResourceResponse, error)
 	RunShell(context.Context, *ShellRequest) (*ShellResponse, error)
+	WatchResources(*WatchResourcesRequest, grpc.ServerStreamingServer[ResourceResponse]) error
+	RunShellStream(*ShellRequest, grpc.ServerStreamingServer[ShellChunk]) error
+	OpenShell(grpc.BidiStreamingServer[ShellInput, ShellOutput]) error
+	SubmitJob(context.Context, *ShellRequest) (*JobInfo, error)
+	GetJob(context.Context, *JobRequest) (*JobInfo, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	CancelJob(context.Context, *JobRequest) (*JobInfo, error)
+	StreamJobOutput(*JobOutputRequest, grpc.ServerStreamingServer[ShellChunk]) error
+	UploadFile(grpc.ClientStreamingServer[FileChunk, FileTransferResult]) error
+	GetUploadOffset(context.Context, *FilePathRequest) (*UploadOffset, error)
+	DownloadFile(*DownloadFileRequest, grpc.ServerStreamingServer[FileChunk]) error
+	StatPath(context.Context, *FilePathRequest) (*FileStat, error)
+	ListDir(context.Context, *ListDirRequest) (*ListDirResponse, error)
+	ReadFileRange(context.Context, *ReadFileRangeRequest) (*FileContent, error)
+	TailFile(*TailFileRequest, grpc.ServerStreamingServer[FileContent]) error
+	WatchPath(*WatchPathRequest, grpc.ServerStreamingServer[FileEvent]) error
+	ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error)
+	GetProcess(context.Context, *ProcessRequest) (*ProcessInfo, error)
+	SignalProcess(context.Context, *SignalProcessRequest) (*SignalProcessResponse, error)
+	SetProcessPriority(context.Context, *SetProcessPriorityRequest) (*ProcessInfo, error)
+	ListUnits(context.Context, *ListUnitsRequest) (*ListUnitsResponse, error)
+	GetUnitStatus(context.Context, *UnitRequest) (*UnitStatus, error)
+	ControlUnit(context.Context, *UnitActionRequest) (*UnitStatus, error)
+	GetUnitJournal(context.Context, *UnitJournalRequest) (*UnitJournalResponse, error)
+	StartContainer(context.Context, *ContainerRequest) (*ContainerActionResponse, error)
+	StopContainer(context.Context, *StopContainerRequest) (*ContainerActionResponse, error)
+	RestartContainer(context.Context, *StopContainerRequest) (*ContainerActionResponse, error)
+	PauseContainer(context.Context, *ContainerRequest) (*ContainerActionResponse, error)
+	UnpauseContainer(context.Context, *ContainerRequest) (*ContainerActionResponse, error)
+	KillContainer(context.Context, *KillContainerRequest) (*ContainerActionResponse, error)
+	RemoveContainer(context.Context, *RemoveContainerRequest) (*ContainerActionResponse, error)
+	ContainerLogs(*ContainerLogsRequest, grpc.ServerStreamingServer[ContainerLogChunk]) error
+	ContainerExec(grpc.BidiStreamingServer[ContainerExecInput, ShellChunk]) error
+	mustEmbedUnimplementedResourceCheckerServer()
+}
+
+// UnimplementedResourceCheckerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResourceCheckerServer struct{}
+
+func (UnimplementedResourceCheckerServer) CheckResources(context.Context, *ResourceRequest) (*ResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckResources not implemented")
+}
+func (UnimplementedResourceCheckerServer) RunShell(context.Context, *ShellRequest) (*ShellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunShell not implemented")
+}
+func (UnimplementedResourceCheckerServer) WatchResources(*WatchResourcesRequest, grpc.ServerStreamingServer[ResourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResources not implemented")
+}
+func (UnimplementedResourceCheckerServer) RunShellStream(*ShellRequest, grpc.ServerStreamingServer[ShellChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method RunShellStream not implemented")
+}
+func (UnimplementedResourceCheckerServer) OpenShell(grpc.BidiStreamingServer[ShellInput, ShellOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method OpenShell not implemented")
+}
+func (UnimplementedResourceCheckerServer) SubmitJob(context.Context, *ShellRequest) (*JobInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJob not implemented")
+}
+func (UnimplementedResourceCheckerServer) GetJob(context.Context, *JobRequest) (*JobInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedResourceCheckerServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedResourceCheckerServer) CancelJob(context.Context, *JobRequest) (*JobInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedResourceCheckerServer) StreamJobOutput(*JobOutputRequest, grpc.ServerStreamingServer[ShellChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamJobOutput not implemented")
+}
+func (UnimplementedResourceCheckerServer) UploadFile(grpc.ClientStreamingServer[FileChunk, FileTransferResult]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedResourceCheckerServer) GetUploadOffset(context.Context, *FilePathRequest) (*UploadOffset, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadOffset not implemented")
+}
+func (UnimplementedResourceCheckerServer) DownloadFile(*DownloadFileRequest, grpc.ServerStreamingServer[FileChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedResourceCheckerServer) StatPath(context.Context, *FilePathRequest) (*FileStat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatPath not implemented")
+}
+func (UnimplementedResourceCheckerServer) ListDir(context.Context, *ListDirRequest) (*ListDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDir not implemented")
+}
+func (UnimplementedResourceCheckerServer) ReadFileRange(context.Context, *ReadFileRangeRequest) (*FileContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadFileRange not implemented")
+}
+func (UnimplementedResourceCheckerServer) TailFile(*TailFileRequest, grpc.ServerStreamingServer[FileContent]) error {
+	return status.Errorf(codes.Unimplemented, "method TailFile not implemented")
+}
+func (UnimplementedResourceCheckerServer) WatchPath(*WatchPathRequest, grpc.ServerStreamingServer[FileEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPath not implemented")
+}
+func (UnimplementedResourceCheckerServer) ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcesses not implemented")
+}
+func (UnimplementedResourceCheckerServer) GetProcess(context.Context, *ProcessRequest) (*ProcessInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcess not implemented")
+}
+func (UnimplementedResourceCheckerServer) SignalProcess(context.Context, *SignalProcessRequest) (*SignalProcessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalProcess not implemented")
+}
+func (UnimplementedResourceCheckerServer) SetProcessPriority(context.Context, *SetProcessPriorityRequest) (*ProcessInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProcessPriority not implemented")
+}
+func (UnimplementedResourceCheckerServer) ListUnits(context.Context, *ListUnitsRequest) (*ListUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnits not implemented")
+}
+func (UnimplementedResourceCheckerServer) GetUnitStatus(context.Context, *UnitRequest) (*UnitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnitStatus not implemented")
+}
+func (UnimplementedResourceCheckerServer) ControlUnit(context.Context, *UnitActionRequest) (*UnitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControlUnit not implemented")
+}
+func (UnimplementedResourceCheckerServer) GetUnitJournal(context.Context, *UnitJournalRequest) (*UnitJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnitJournal not implemented")
+}
+func (UnimplementedResourceCheckerServer) StartContainer(context.Context, *ContainerRequest) (*ContainerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartContainer not implemented")
+}
+func (UnimplementedResourceCheckerServer) StopContainer(context.Context, *StopContainerRequest) (*ContainerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopContainer not implemented")
+}
+func (UnimplementedResourceCheckerServer) RestartContainer(context.Context, *StopContainerRequest) (*ContainerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartContainer not implemented")
+}
+func (UnimplementedResourceCheckerServer) PauseContainer(context.Context, *ContainerRequest) (*ContainerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseContainer not implemented")
+}
+func (UnimplementedResourceCheckerServer) UnpauseContainer(context.Context, *ContainerRequest) (*ContainerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseContainer not implemented")
+}
+func (UnimplementedResourceCheckerServer) KillContainer(context.Context, *KillContainerRequest) (*ContainerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillContainer not implemented")
+}
+func (UnimplementedResourceCheckerServer) RemoveContainer(context.Context, *RemoveContainerRequest) (*ContainerActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContainer not implemented")
+}
+func (UnimplementedResourceCheckerServer) ContainerLogs(*ContainerLogsRequest, grpc.ServerStreamingServer[ContainerLogChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerLogs not implemented")
+}
+func (UnimplementedResourceCheckerServer) ContainerExec(grpc.BidiStreamingServer[ContainerExecInput, ShellChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerExec not implemented")
+}
+func (UnimplementedResourceCheckerServer) mustEmbedUnimplementedResourceCheckerServer() {}
+func (UnimplementedResourceCheckerServer) testEmbeddedByValue()                         {}
+
+// UnsafeResourceCheckerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResourceCheckerServer will
+// result in compilation errors.
+type UnsafeResourceCheckerServer interface {
 	mustEmbedUnimplementedResourceCheckerServer()
 }
 
-// UnimplementedResourceCheckerServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedResourceCheckerServer struct{}
+func RegisterResourceCheckerServer(s grpc.ServiceRegistrar, srv ResourceCheckerServer) {
+	// If the following call pancis, it indicates UnimplementedResourceCheckerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ResourceChecker_ServiceDesc, srv)
+}
+
+func _ResourceChecker_CheckResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).CheckResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_CheckResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).CheckResources(ctx, req.(*ResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_RunShell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).RunShell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_RunShell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).RunShell(ctx, req.(*ShellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_WatchResources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceCheckerServer).WatchResources(m, &grpc.GenericServerStream[WatchResourcesRequest, ResourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_WatchResourcesServer = grpc.ServerStreamingServer[ResourceResponse]
+
+func _ResourceChecker_RunShellStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ShellRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceCheckerServer).RunShellStream(m, &grpc.GenericServerStream[ShellRequest, ShellChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_RunShellStreamServer = grpc.ServerStreamingServer[ShellChunk]
+
+func _ResourceChecker_OpenShell_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ResourceCheckerServer).OpenShell(&grpc.GenericServerStream[ShellInput, ShellOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_OpenShellServer = grpc.BidiStreamingServer[ShellInput, ShellOutput]
+
+func _ResourceChecker_SubmitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).SubmitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_SubmitJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).SubmitJob(ctx, req.(*ShellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).GetJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).CancelJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_StreamJobOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobOutputRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceCheckerServer).StreamJobOutput(m, &grpc.GenericServerStream[JobOutputRequest, ShellChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_StreamJobOutputServer = grpc.ServerStreamingServer[ShellChunk]
+
+func _ResourceChecker_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ResourceCheckerServer).UploadFile(&grpc.GenericServerStream[FileChunk, FileTransferResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_UploadFileServer = grpc.ClientStreamingServer[FileChunk, FileTransferResult]
+
+func _ResourceChecker_GetUploadOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).GetUploadOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_GetUploadOffset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).GetUploadOffset(ctx, req.(*FilePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceCheckerServer).DownloadFile(m, &grpc.GenericServerStream[DownloadFileRequest, FileChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_DownloadFileServer = grpc.ServerStreamingServer[FileChunk]
+
+func _ResourceChecker_StatPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).StatPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_StatPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).StatPath(ctx, req.(*FilePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_ListDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).ListDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_ListDir_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).ListDir(ctx, req.(*ListDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_ReadFileRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadFileRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).ReadFileRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_ReadFileRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).ReadFileRange(ctx, req.(*ReadFileRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_TailFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceCheckerServer).TailFile(m, &grpc.GenericServerStream[TailFileRequest, FileContent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_TailFileServer = grpc.ServerStreamingServer[FileContent]
+
+func _ResourceChecker_WatchPath_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPathRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceCheckerServer).WatchPath(m, &grpc.GenericServerStream[WatchPathRequest, FileEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_WatchPathServer = grpc.ServerStreamingServer[FileEvent]
+
+func _ResourceChecker_ListProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).ListProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_ListProcesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).ListProcesses(ctx, req.(*ListProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_GetProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).GetProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_GetProcess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).GetProcess(ctx, req.(*ProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedResourceCheckerServer) CheckResources(context.Context, *ResourceRequest) (*ResourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckResources not implemented")
+func _ResourceChecker_SignalProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).SignalProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_SignalProcess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).SignalProcess(ctx, req.(*SignalProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedResourceCheckerServer) RunShell(context.Context, *ShellRequest) (*ShellResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunShell not implemented")
+
+func _ResourceChecker_SetProcessPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProcessPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).SetProcessPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_SetProcessPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).SetProcessPriority(ctx, req.(*SetProcessPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedResourceCheckerServer) mustEmbedUnimplementedResourceCheckerServer() {}
-func (UnimplementedResourceCheckerServer) testEmbeddedByValue()                         {}
 
-// UnsafeResourceCheckerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ResourceCheckerServer will
-// result in compilation errors.
-type UnsafeResourceCheckerServer interface {
-	mustEmbedUnimplementedResourceCheckerServer()
+func _ResourceChecker_ListUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).ListUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_ListUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).ListUnits(ctx, req.(*ListUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterResourceCheckerServer(s grpc.ServiceRegistrar, srv ResourceCheckerServer) {
-	// If the following call pancis, it indicates UnimplementedResourceCheckerServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ResourceChecker_GetUnitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ResourceChecker_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).GetUnitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_GetUnitStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).GetUnitStatus(ctx, req.(*UnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ResourceChecker_CheckResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceRequest)
+func _ResourceChecker_ControlUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnitActionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ResourceCheckerServer).CheckResources(ctx, in)
+		return srv.(ResourceCheckerServer).ControlUnit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ResourceChecker_CheckResources_FullMethodName,
+		FullMethod: ResourceChecker_ControlUnit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ResourceCheckerServer).CheckResources(ctx, req.(*ResourceRequest))
+		return srv.(ResourceCheckerServer).ControlUnit(ctx, req.(*UnitActionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ResourceChecker_RunShell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShellRequest)
+func _ResourceChecker_GetUnitJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnitJournalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ResourceCheckerServer).RunShell(ctx, in)
+		return srv.(ResourceCheckerServer).GetUnitJournal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ResourceChecker_RunShell_FullMethodName,
+		FullMethod: ResourceChecker_GetUnitJournal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ResourceCheckerServer).RunShell(ctx, req.(*ShellRequest))
+		return srv.(ResourceCheckerServer).GetUnitJournal(ctx, req.(*UnitJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_StartContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).StartContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_StartContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).StartContainer(ctx, req.(*ContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_StopContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).StopContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_StopContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).StopContainer(ctx, req.(*StopContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_RestartContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).RestartContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_RestartContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).RestartContainer(ctx, req.(*StopContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_PauseContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).PauseContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_PauseContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).PauseContainer(ctx, req.(*ContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_UnpauseContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).UnpauseContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_UnpauseContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).UnpauseContainer(ctx, req.(*ContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_KillContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).KillContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_KillContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).KillContainer(ctx, req.(*KillContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceChecker_RemoveContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceCheckerServer).RemoveContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceChecker_RemoveContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceCheckerServer).RemoveContainer(ctx, req.(*RemoveContainerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceChecker_ContainerLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContainerLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceCheckerServer).ContainerLogs(m, &grpc.GenericServerStream[ContainerLogsRequest, ContainerLogChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_ContainerLogsServer = grpc.ServerStreamingServer[ContainerLogChunk]
+
+func _ResourceChecker_ContainerExec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ResourceCheckerServer).ContainerExec(&grpc.GenericServerStream[ContainerExecInput, ShellChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceChecker_ContainerExecServer = grpc.BidiStreamingServer[ContainerExecInput, ShellChunk]
+
 // ResourceChecker_ServiceDesc is the grpc.ServiceDesc for ResourceChecker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +1265,152 @@ var ResourceChecker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunShell",
 			Handler:    _ResourceChecker_RunShell_Handler,
 		},
+		{
+			MethodName: "SubmitJob",
+			Handler:    _ResourceChecker_SubmitJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _ResourceChecker_GetJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _ResourceChecker_ListJobs_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _ResourceChecker_CancelJob_Handler,
+		},
+		{
+			MethodName: "GetUploadOffset",
+			Handler:    _ResourceChecker_GetUploadOffset_Handler,
+		},
+		{
+			MethodName: "StatPath",
+			Handler:    _ResourceChecker_StatPath_Handler,
+		},
+		{
+			MethodName: "ListDir",
+			Handler:    _ResourceChecker_ListDir_Handler,
+		},
+		{
+			MethodName: "ReadFileRange",
+			Handler:    _ResourceChecker_ReadFileRange_Handler,
+		},
+		{
+			MethodName: "ListProcesses",
+			Handler:    _ResourceChecker_ListProcesses_Handler,
+		},
+		{
+			MethodName: "GetProcess",
+			Handler:    _ResourceChecker_GetProcess_Handler,
+		},
+		{
+			MethodName: "SignalProcess",
+			Handler:    _ResourceChecker_SignalProcess_Handler,
+		},
+		{
+			MethodName: "SetProcessPriority",
+			Handler:    _ResourceChecker_SetProcessPriority_Handler,
+		},
+		{
+			MethodName: "ListUnits",
+			Handler:    _ResourceChecker_ListUnits_Handler,
+		},
+		{
+			MethodName: "GetUnitStatus",
+			Handler:    _ResourceChecker_GetUnitStatus_Handler,
+		},
+		{
+			MethodName: "ControlUnit",
+			Handler:    _ResourceChecker_ControlUnit_Handler,
+		},
+		{
+			MethodName: "GetUnitJournal",
+			Handler:    _ResourceChecker_GetUnitJournal_Handler,
+		},
+		{
+			MethodName: "StartContainer",
+			Handler:    _ResourceChecker_StartContainer_Handler,
+		},
+		{
+			MethodName: "StopContainer",
+			Handler:    _ResourceChecker_StopContainer_Handler,
+		},
+		{
+			MethodName: "RestartContainer",
+			Handler:    _ResourceChecker_RestartContainer_Handler,
+		},
+		{
+			MethodName: "PauseContainer",
+			Handler:    _ResourceChecker_PauseContainer_Handler,
+		},
+		{
+			MethodName: "UnpauseContainer",
+			Handler:    _ResourceChecker_UnpauseContainer_Handler,
+		},
+		{
+			MethodName: "KillContainer",
+			Handler:    _ResourceChecker_KillContainer_Handler,
+		},
+		{
+			MethodName: "RemoveContainer",
+			Handler:    _ResourceChecker_RemoveContainer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResources",
+			Handler:       _ResourceChecker_WatchResources_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RunShellStream",
+			Handler:       _ResourceChecker_RunShellStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "OpenShell",
+			Handler:       _ResourceChecker_OpenShell_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamJobOutput",
+			Handler:       _ResourceChecker_StreamJobOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadFile",
+			Handler:       _ResourceChecker_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _ResourceChecker_DownloadFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TailFile",
+			Handler:       _ResourceChecker_TailFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPath",
+			Handler:       _ResourceChecker_WatchPath_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ContainerLogs",
+			Handler:       _ResourceChecker_ContainerLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ContainerExec",
+			Handler:       _ResourceChecker_ContainerExec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/agent.proto",
 }
@@ -0,0 +1,140 @@
+//go:build linux
+
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	pb "server_agent/module/proto"
+
+	"github.com/shirou/gopsutil/disk"
+)
+
+// 默认排除的文件系统类型，均为内存或内核提供的虚拟文件系统
+var defaultExcludeFsTypes = []string{
+	"tmpfs", "devtmpfs", "overlay", "squashfs", "proc", "sysfs", "cgroup", "cgroup2",
+	"devpts", "mqueue", "debugfs", "tracefs", "securityfs", "pstore", "bpf", "autofs",
+	"hugetlbfs", "configfs", "fusectl", "binfmt_misc", "nsfs", "rpc_pipefs", "ramfs",
+	"efivarfs", "fuse.lxcfs",
+}
+
+// 默认排除的挂载点（glob），容器运行时会在这些目录下挂载大量重复的文件系统
+var defaultExcludeMounts = []string{
+	"/proc/*", "/sys/*", "/dev/*", "/run/*", "/snap/*",
+	"/var/lib/docker/*", "/var/lib/kubelet/*", "/run/containerd/*",
+}
+
+// statfsTimeout 是单个挂载点 statfs 的最长等待时间，NFS 服务端失联时 statfs 会一直阻塞
+const statfsTimeout = 2 * time.Second
+
+// filesystemFilter 决定哪些挂载点需要上报
+type filesystemFilter struct {
+	excludeTypes  []string
+	excludeMounts []string
+}
+
+var fsFilter = filesystemFilter{excludeTypes: defaultExcludeFsTypes, excludeMounts: defaultExcludeMounts}
+
+// 仍在等待 statfs 返回的挂载点，阻塞期间不再重复发起调用，避免 goroutine 堆积
+var (
+	pendingStatfsMu sync.Mutex
+	pendingStatfs   = map[string]bool{}
+)
+
+// splitList 解析逗号分隔的配置项
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (f filesystemFilter) excluded(p disk.PartitionStat) bool {
+	for _, fstype := range f.excludeTypes {
+		if p.Fstype == fstype {
+			return true
+		}
+	}
+	for _, pattern := range f.excludeMounts {
+		if ok, _ := filepath.Match(pattern, p.Mountpoint); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// collectFilesystems 返回所有未被排除的挂载点的容量和 inode 使用情况。
+// 同一挂载点被多次挂载时只保留最后一次（即当前可见的那个）
+func collectFilesystems() ([]*pb.FilesystemInfo, error) {
+	partitions, err := disk.Partitions(true)
+	if err != nil {
+		return nil, err
+	}
+	index := map[string]int{}
+	var result []*pb.FilesystemInfo
+	for _, p := range partitions {
+		if fsFilter.excluded(p) {
+			continue
+		}
+		usage, ok := usageWithTimeout(p.Mountpoint)
+		if !ok {
+			continue
+		}
+		info := &pb.FilesystemInfo{
+			Device:        p.Device,
+			Fstype:        p.Fstype,
+			Mountpoint:    p.Mountpoint,
+			Total:         usage.Total,
+			Used:          usage.Used,
+			Free:          usage.Free,
+			UsedPercent:   roundToTwoDecimalPlaces(usage.UsedPercent),
+			InodesTotal:   usage.InodesTotal,
+			InodesUsed:    usage.InodesUsed,
+			InodesFree:    usage.InodesFree,
+			InodesPercent: roundToTwoDecimalPlaces(usage.InodesUsedPercent),
+		}
+		if i, ok := index[p.Mountpoint]; ok {
+			result[i] = info
+			continue
+		}
+		index[p.Mountpoint] = len(result)
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// usageWithTimeout 在超时内获取挂载点的使用情况，超时或出错时返回 false
+func usageWithTimeout(mountpoint string) (*disk.UsageStat, bool) {
+	pendingStatfsMu.Lock()
+	if pendingStatfs[mountpoint] {
+		pendingStatfsMu.Unlock()
+		return nil, false
+	}
+	pendingStatfs[mountpoint] = true
+	pendingStatfsMu.Unlock()
+
+	done := make(chan *disk.UsageStat, 1)
+	go func() {
+		usage, err := disk.Usage(mountpoint)
+		pendingStatfsMu.Lock()
+		delete(pendingStatfs, mountpoint)
+		pendingStatfsMu.Unlock()
+		if err != nil {
+			usage = nil
+		}
+		done <- usage
+	}()
+
+	select {
+	case usage := <-done:
+		return usage, usage != nil
+	case <-time.After(statfsTimeout):
+		return nil, false
+	}
+}
@@ -0,0 +1,141 @@
+//go:build linux
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "server_agent/module/proto"
+
+	gopsutilNet "github.com/shirou/gopsutil/net"
+)
+
+// 默认排除的网卡（glob）：回环和容器、虚拟机使用的虚拟网卡，它们的流量已经计入物理网卡
+var defaultExcludeIfaces = []string{
+	"lo", "docker*", "veth*", "br-*", "virbr*", "cni*", "flannel*", "cali*", "vxlan*", "kube-*",
+}
+
+// interfaceFilter 决定哪些网卡参与统计，include 非空时只统计匹配的网卡
+type interfaceFilter struct {
+	include []string
+	exclude []string
+}
+
+var ifaceFilter = interfaceFilter{exclude: defaultExcludeIfaces}
+
+func (f interfaceFilter) matches(name string) bool {
+	match := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := filepath.Match(pattern, name); ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(f.include) > 0 && !match(f.include) {
+		return false
+	}
+	return !match(f.exclude)
+}
+
+// readNetCounters 读取参与统计的网卡的计数器，并返回它们的合计
+func readNetCounters() (map[string]gopsutilNet.IOCountersStat, gopsutilNet.IOCountersStat, error) {
+	perNic, err := gopsutilNet.IOCounters(true)
+	if err != nil {
+		return nil, gopsutilNet.IOCountersStat{}, err
+	}
+	counters := map[string]gopsutilNet.IOCountersStat{}
+	total := gopsutilNet.IOCountersStat{Name: "all"}
+	for _, c := range perNic {
+		if !ifaceFilter.matches(c.Name) {
+			continue
+		}
+		counters[c.Name] = c
+		total.BytesSent += c.BytesSent
+		total.BytesRecv += c.BytesRecv
+		total.PacketsSent += c.PacketsSent
+		total.PacketsRecv += c.PacketsRecv
+		total.Errin += c.Errin
+		total.Errout += c.Errout
+		total.Dropin += c.Dropin
+		total.Dropout += c.Dropout
+	}
+	return counters, total, nil
+}
+
+// collectInterfaces 返回参与统计的网卡的属性、累计计数器以及两次采样之间的速率（每秒）
+func collectInterfaces(prev, cur map[string]gopsutilNet.IOCountersStat, elapsed time.Duration) []*pb.InterfaceInfo {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return nil
+	}
+	sort.Slice(interfaces, func(i, j int) bool { return interfaces[i].Name < interfaces[j].Name })
+
+	seconds := elapsed.Seconds()
+	rate := func(before, after uint64) float64 {
+		// 计数器回绕或网卡重置时不计算
+		if seconds <= 0 || after < before {
+			return 0
+		}
+		return roundToTwoDecimalPlaces(float64(after-before) / seconds)
+	}
+
+	var result []*pb.InterfaceInfo
+	for _, iface := range interfaces {
+		if !ifaceFilter.matches(iface.Name) {
+			continue
+		}
+		info := &pb.InterfaceInfo{
+			Name:      iface.Name,
+			Mtu:       int32(iface.MTU),
+			Mac:       iface.HardwareAddr.String(),
+			Up:        iface.Flags&net.FlagUp != 0,
+			OperState: readSysNet(iface.Name, "operstate"),
+		}
+		if speed, err := strconv.Atoi(readSysNet(iface.Name, "speed")); err == nil && speed > 0 {
+			info.SpeedMbps = int32(speed)
+		}
+		if addrs, err := iface.Addrs(); err == nil {
+			for _, addr := range addrs {
+				ipNet, ok := addr.(*net.IPNet)
+				if !ok {
+					continue
+				}
+				if ipNet.IP.To4() != nil {
+					info.Ipv4 = append(info.Ipv4, ipNet.String())
+				} else {
+					info.Ipv6 = append(info.Ipv6, ipNet.String())
+				}
+			}
+		}
+		if c, ok := cur[iface.Name]; ok {
+			info.BytesSent, info.BytesRecv = c.BytesSent, c.BytesRecv
+			info.PacketsSent, info.PacketsRecv = c.PacketsSent, c.PacketsRecv
+			info.ErrorsIn, info.ErrorsOut = c.Errin, c.Errout
+			info.DropsIn, info.DropsOut = c.Dropin, c.Dropout
+			if p, ok := prev[iface.Name]; ok {
+				info.BytesSentPerSec, info.BytesRecvPerSec = rate(p.BytesSent, c.BytesSent), rate(p.BytesRecv, c.BytesRecv)
+				info.PacketsSentPerSec, info.PacketsRecvPerSec = rate(p.PacketsSent, c.PacketsSent), rate(p.PacketsRecv, c.PacketsRecv)
+				info.ErrorsPerSec = rate(p.Errin+p.Errout, c.Errin+c.Errout)
+				info.DropsPerSec = rate(p.Dropin+p.Dropout, c.Dropin+c.Dropout)
+			}
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+// readSysNet 读取 /sys/class/net 下网卡的属性，读取失败（如虚拟网卡没有 speed）时返回空字符串
+func readSysNet(name, attr string) string {
+	data, err := os.ReadFile(filepath.Join("/sys/class/net", name, attr))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,273 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// commandPolicy 是 RunShell 等接口执行命令前检查的策略，从 POLICY_FILE 指定的 JSON 文件加载。
+// 规则按顺序匹配，第一条命中的规则决定结果，全部未命中时使用 default_action
+type commandPolicy struct {
+	DefaultAction string       `json:"default_action"` // allow 或 deny，默认 deny
+	Rules         []policyRule `json:"rules"`
+}
+
+// policyRule 描述一条策略规则，regex、executable、argv_prefix 中指定的条件需全部满足，
+// 都不指定时匹配任意命令
+type policyRule struct {
+	Name       string   `json:"name"`
+	Action     string   `json:"action"`      // allow 或 deny
+	Tokens     []string `json:"tokens"`      // 规则适用的令牌，为空时适用于所有令牌
+	Regex      string   `json:"regex"`       // 匹配完整命令字符串的正则表达式
+	Executable string   `json:"executable"`  // 可执行文件名，不含 / 时按命令名匹配
+	ArgvPrefix []string `json:"argv_prefix"` // 参数前缀，第一个元素按 executable 的规则匹配
+
+	re *regexp.Regexp
+}
+
+// policy 为 nil 表示未配置策略，所有命令均允许执行
+var policy *commandPolicy
+
+// loadPolicy 读取并校验策略文件
+func loadPolicy(file string) (*commandPolicy, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("读取策略文件失败: %v", err)
+	}
+	var p commandPolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("解析策略文件失败: %v", err)
+	}
+	if p.DefaultAction == "" {
+		p.DefaultAction = "deny"
+	}
+	if p.DefaultAction != "allow" && p.DefaultAction != "deny" {
+		return nil, fmt.Errorf("无效的 default_action: %s", p.DefaultAction)
+	}
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if rule.Action != "allow" && rule.Action != "deny" {
+			return nil, fmt.Errorf("规则 %d (%s) 的 action 无效: %s", i, rule.Name, rule.Action)
+		}
+		if rule.Regex != "" {
+			if rule.re, err = regexp.Compile(rule.Regex); err != nil {
+				return nil, fmt.Errorf("规则 %d (%s) 的正则表达式无效: %v", i, rule.Name, err)
+			}
+		}
+	}
+	return &p, nil
+}
+
+// checkCommandPolicy 检查调用方的令牌是否允许执行该命令
+func checkCommandPolicy(ctx context.Context, command string) error {
+	if policy == nil {
+		return nil
+	}
+	token := requestToken(ctx)
+	segments, simple := parseCommand(command)
+	for _, rule := range policy.Rules {
+		if !rule.matches(token, command, segments, simple) {
+			continue
+		}
+		if rule.Action == "allow" {
+			return nil
+		}
+		return fmt.Errorf("命令被策略规则 %q 拒绝", rule.Name)
+	}
+	if policy.DefaultAction == "allow" {
+		return nil
+	}
+	return fmt.Errorf("命令未被任何策略规则允许")
+}
+
+// checkInteractivePolicy 配置策略时只允许能以 root 执行的令牌打开交互式终端。策略只检查启动的命令，
+// 终端中被允许的命令仍会读取用户输入，如 systemctl status 打开的分页器中输入 !sh 即可得到不受限制的 shell
+func checkInteractivePolicy(ctx context.Context) error {
+	if policy == nil || tokenAllowsRoot(requestToken(ctx)) {
+		return nil
+	}
+	return fmt.Errorf("配置命令策略时该令牌不允许打开交互式终端")
+}
+
+// policyPath 是配置策略后命令使用的 PATH，不继承 agent 的 PATH，避免命令名解析到意外的位置
+const policyPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+// unsafePolicyEnv 判断环境变量是否会改变 bash 或动态链接器的行为。策略只检查命令字符串，
+// 这些变量却能让被允许的命令执行任意代码，如 BASH_ENV=/dev/stdin 执行标准输入中的脚本、
+// BASH_FUNC_df%% 用导出的函数替换 df
+func unsafePolicyEnv(key string) bool {
+	switch key {
+	case "BASH_ENV", "ENV", "SHELLOPTS", "BASHOPTS", "PS4", "PATH", "IFS", "CDPATH", "GLOBIGNORE", "PROMPT_COMMAND":
+		return true
+	}
+	return strings.HasPrefix(key, "BASH_FUNC_") || strings.HasPrefix(key, "LD_")
+}
+
+// checkPolicyEnv 配置策略后拒绝在请求中设置 unsafePolicyEnv 中的变量
+func checkPolicyEnv(env map[string]string) error {
+	if policy == nil {
+		return nil
+	}
+	for key := range env {
+		if unsafePolicyEnv(key) {
+			return fmt.Errorf("配置命令策略时不允许设置环境变量 %s", key)
+		}
+	}
+	return nil
+}
+
+func (r *policyRule) matches(token, command string, segments [][]string, simple bool) bool {
+	if len(r.Tokens) > 0 && !containsToken(r.Tokens, token) {
+		return false
+	}
+	if r.re != nil && !r.re.MatchString(command) {
+		return false
+	}
+
+	// 带条件的 allow 规则只放行不含管道、重定向、变量展开等语法的单条命令，
+	// 否则 "df; rm -rf /" 之类的命令会借助 df 的规则（包括只有 regex "^df" 的规则）通过；
+	// deny 规则则只要命令中任意一段匹配即生效
+	if r.Executable == "" && len(r.ArgvPrefix) == 0 {
+		if r.Action == "allow" && r.re != nil {
+			return simple && len(segments) == 1
+		}
+		return true
+	}
+	if r.Action == "allow" {
+		return simple && len(segments) == 1 && r.matchesArgv(segments[0], true)
+	}
+	for _, argv := range segments {
+		if r.matchesArgv(argv, false) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *policyRule) matchesArgv(argv []string, strict bool) bool {
+	// 跳过命令前的环境变量赋值，如 "LANG=C df"
+	for len(argv) > 0 && isAssignment(argv[0]) {
+		argv = argv[1:]
+	}
+	if len(argv) == 0 {
+		return false
+	}
+	if r.Executable != "" && !matchesExecutable(argv[0], r.Executable, strict) {
+		return false
+	}
+	if len(r.ArgvPrefix) > 0 {
+		if len(argv) < len(r.ArgvPrefix) || !matchesExecutable(argv[0], r.ArgvPrefix[0], strict) {
+			return false
+		}
+		for i := 1; i < len(r.ArgvPrefix); i++ {
+			if argv[i] != r.ArgvPrefix[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchesExecutable 比较命令名。want 含 / 时要求完全一致；否则 strict 模式下
+// 只接受通过 PATH 查找的命令名，非 strict 模式下同时匹配任意路径下的同名文件
+func matchesExecutable(word, want string, strict bool) bool {
+	if word == want {
+		return true
+	}
+	if strings.Contains(want, "/") || strict {
+		return false
+	}
+	return path.Base(word) == want
+}
+
+func isAssignment(word string) bool {
+	name, _, ok := strings.Cut(word, "=")
+	return ok && name != "" && !strings.ContainsAny(name, "/-.")
+}
+
+// parseCommand 按 shell 的引号规则把命令拆分为若干段参数列表。
+// simple 为 false 表示命令包含多段命令、重定向、子 shell、变量或命令替换、
+// 环境变量赋值或未闭合的引号，此时参数列表不能代表实际执行的程序
+func parseCommand(command string) (segments [][]string, simple bool) {
+	simple = true
+	var argv []string
+	var word strings.Builder
+	inWord, inSingle, inDouble, escaped := false, false, false, false
+
+	endWord := func() {
+		if inWord {
+			argv = append(argv, word.String())
+			if len(argv) == 1 && isAssignment(argv[0]) {
+				simple = false
+			}
+			word.Reset()
+			inWord = false
+		}
+	}
+	endSegment := func() {
+		endWord()
+		if len(argv) > 0 {
+			segments = append(segments, argv)
+			argv = nil
+		}
+	}
+
+	for _, c := range command {
+		switch {
+		case escaped:
+			word.WriteRune(c)
+			inWord, escaped = true, false
+		case inSingle:
+			if c == '\'' {
+				inSingle = false
+			} else {
+				word.WriteRune(c)
+			}
+		case inDouble:
+			switch c {
+			case '"':
+				inDouble = false
+			case '\\':
+				escaped = true
+			case '$', '`':
+				simple = false
+				word.WriteRune(c)
+			default:
+				word.WriteRune(c)
+			}
+		case c == '\\':
+			escaped, inWord = true, true
+		case c == '\'':
+			inSingle, inWord = true, true
+		case c == '"':
+			inDouble, inWord = true, true
+		case c == ' ' || c == '\t':
+			endWord()
+		case strings.ContainsRune(";&|\n", c):
+			simple = false
+			endSegment()
+		case strings.ContainsRune("<>()", c):
+			simple = false
+			endWord()
+		case strings.ContainsRune("$`*?[{~", c):
+			simple = false
+			word.WriteRune(c)
+			inWord = true
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if inSingle || inDouble || escaped {
+		simple = false
+	}
+	endSegment()
+	return segments, simple
+}
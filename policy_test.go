@@ -0,0 +1,286 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		command  string
+		segments [][]string // 为 nil 时不检查拆分结果
+		simple   bool
+	}{
+		{"df -h", [][]string{{"df", "-h"}}, true},
+		{"  df \t -h  ", [][]string{{"df", "-h"}}, true},
+		{"", nil, true},
+
+		// 多段命令
+		{"df; rm -rf /", [][]string{{"df"}, {"rm", "-rf", "/"}}, false},
+		{"df && rm x", [][]string{{"df"}, {"rm", "x"}}, false},
+		{"df || rm x", [][]string{{"df"}, {"rm", "x"}}, false},
+		{"df | sh", [][]string{{"df"}, {"sh"}}, false},
+		{"df & rm x", [][]string{{"df"}, {"rm", "x"}}, false},
+		{"df\nrm x", [][]string{{"df"}, {"rm", "x"}}, false},
+
+		// 引号和转义
+		{`echo 'a b' "c d"`, [][]string{{"echo", "a b", "c d"}}, true},
+		{`echo a\ b`, [][]string{{"echo", "a b"}}, true},
+		{`echo "a;b" 'c|d'`, [][]string{{"echo", "a;b", "c|d"}}, true},
+		{`echo a\;b`, [][]string{{"echo", "a;b"}}, true},
+		{`echo "a\"b"`, [][]string{{"echo", `a"b`}}, true},
+		{`echo '$HOME'`, [][]string{{"echo", "$HOME"}}, true},
+		{`d""f -h`, [][]string{{"df", "-h"}}, true},
+		{`echo 'unterminated`, nil, false},
+		{`echo "unterminated`, nil, false},
+		{`echo trailing\`, nil, false},
+
+		// 变量、命令替换和进程替换
+		{"echo $HOME", nil, false},
+		{`echo "$HOME"`, nil, false},
+		{"echo $(rm x)", nil, false},
+		{`echo "$(rm x)"`, nil, false},
+		{"echo `rm x`", nil, false},
+		{"echo \"`rm x`\"", nil, false},
+		{"diff <(ls) x", nil, false},
+		{"df > /etc/passwd", nil, false},
+		{"(df)", nil, false},
+
+		// 前置的环境变量赋值
+		{"LANG=C df", [][]string{{"LANG=C", "df"}}, false},
+		{"df LANG=C", [][]string{{"df", "LANG=C"}}, true},
+		{"./a=b", [][]string{{"./a=b"}}, true},
+
+		// glob、花括号和 ~
+		{"ls *.log", nil, false},
+		{"ls file?", nil, false},
+		{"ls [ab]", nil, false},
+		{"echo {a,b}", nil, false},
+		{"cat ~/x", nil, false},
+		{"ls '*.log'", [][]string{{"ls", "*.log"}}, true},
+	}
+	for _, tt := range tests {
+		segments, simple := parseCommand(tt.command)
+		if simple != tt.simple {
+			t.Errorf("parseCommand(%q) simple = %v, want %v", tt.command, simple, tt.simple)
+		}
+		if tt.segments != nil && !reflect.DeepEqual(segments, tt.segments) {
+			t.Errorf("parseCommand(%q) segments = %q, want %q", tt.command, segments, tt.segments)
+		}
+	}
+}
+
+func TestCheckCommandPolicy(t *testing.T) {
+	useTestPolicy(t, `{
+		"rules": [
+			{"name": "no-rm", "action": "deny", "executable": "rm"},
+			{"name": "df", "action": "allow", "executable": "df"},
+			{"name": "status", "action": "allow", "argv_prefix": ["systemctl", "status"]},
+			{"name": "free", "action": "allow", "executable": "/usr/bin/free"},
+			{"name": "ls", "action": "allow", "regex": "^ls"},
+			{"name": "ops-uptime", "action": "allow", "tokens": ["ops"], "regex": "^uptime$"},
+			{"name": "ops-any", "action": "deny", "tokens": ["ops"], "regex": "reboot"}
+		]
+	}`)
+
+	tests := []struct {
+		token   string
+		command string
+		allowed bool
+	}{
+		{"tok", "df", true},
+		{"tok", "df -h /", true},
+		{"tok", "'df' -h", true},
+
+		// allow 规则只放行单条简单命令
+		{"tok", "df; rm -rf /", false},
+		{"tok", "df && id", false},
+		{"tok", "df | sh", false},
+		{"tok", "df\nid", false},
+		{"tok", "df $(id)", false},
+		{"tok", "df `id`", false},
+		{"tok", "df <(id)", false},
+		{"tok", "df > /tmp/x", false},
+		{"tok", "df *", false},
+		{"tok", "df {a,b}", false},
+		{"tok", "LANG=C df", false},
+		{"tok", "BASH_ENV=/dev/stdin df", false},
+
+		// strict 模式下带路径的命令不匹配裸命令名
+		{"tok", "/usr/bin/df", false},
+		{"tok", "./df", false},
+		{"tok", "/usr/bin/free -m", true},
+		{"tok", "free -m", false},
+
+		// deny 规则匹配任意段和任意路径下的同名命令
+		{"tok", "rm x", false},
+		{"tok", "/bin/rm x", false},
+
+		// argv_prefix
+		{"tok", "systemctl status nginx", true},
+		{"tok", "systemctl status", true},
+		{"tok", "systemctl restart nginx", false},
+		{"tok", "systemctl", false},
+
+		// 只有 regex 的 allow 规则同样只放行单条简单命令
+		{"tok", "ls -l /tmp", true},
+		{"tok", "ls; id", false},
+		{"tok", "ls && id", false},
+		{"tok", "ls | sh", false},
+		{"tok", "ls $(id)", false},
+		{"tok", "ls\nid", false},
+
+		// 按令牌生效的规则
+		{"ops", "uptime", true},
+		{"tok", "uptime", false},
+		{"ops", "df", true},
+
+		// 未命中任何规则时使用默认的 deny
+		{"tok", "id", false},
+		{"", "df", true},
+	}
+	for _, tt := range tests {
+		err := checkCommandPolicy(tokenContext(tt.token), tt.command)
+		if (err == nil) != tt.allowed {
+			t.Errorf("token %q command %q: err = %v, want allowed = %v", tt.token, tt.command, err, tt.allowed)
+		}
+	}
+}
+
+func TestCheckCommandPolicyDefaultAllow(t *testing.T) {
+	useTestPolicy(t, `{
+		"default_action": "allow",
+		"rules": [
+			{"name": "no-rm", "action": "deny", "executable": "rm"},
+			{"name": "no-shutdown", "action": "deny", "regex": "\\bshutdown\\b"}
+		]
+	}`)
+
+	tests := []struct {
+		command string
+		allowed bool
+	}{
+		{"id", true},
+		{"ls -l | wc -l", true},
+		{"rm x", false},
+		{"id; rm x", false},
+		{"id && /bin/rm x", false},
+		{"shutdown -h now", false},
+	}
+	for _, tt := range tests {
+		err := checkCommandPolicy(tokenContext("tok"), tt.command)
+		if (err == nil) != tt.allowed {
+			t.Errorf("command %q: err = %v, want allowed = %v", tt.command, err, tt.allowed)
+		}
+	}
+}
+
+func TestLoadPolicyErrors(t *testing.T) {
+	tests := []string{
+		`{"default_action": "maybe"}`,
+		`{"rules": [{"name": "x", "action": "permit"}]}`,
+		`{"rules": [{"name": "x", "action": "allow", "regex": "("}]}`,
+		`not json`,
+	}
+	for _, data := range tests {
+		if _, err := loadPolicy(writePolicy(t, data)); err == nil {
+			t.Errorf("loadPolicy(%s) succeeded, want error", data)
+		}
+	}
+	if _, err := loadPolicy(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("loadPolicy of a missing file succeeded, want error")
+	}
+}
+
+func TestCheckPolicyEnv(t *testing.T) {
+	env := map[string]string{"BASH_ENV": "/dev/stdin"}
+	if err := checkPolicyEnv(env); err != nil {
+		t.Errorf("checkPolicyEnv without a policy: %v", err)
+	}
+
+	useTestPolicy(t, `{}`)
+	tests := []struct {
+		key     string
+		allowed bool
+	}{
+		{"LANG", true},
+		{"HOME", true},
+		{"BASH_ENV", false},
+		{"ENV", false},
+		{"BASH_FUNC_df%%", false},
+		{"BASH_FUNC_df()", false},
+		{"SHELLOPTS", false},
+		{"BASHOPTS", false},
+		{"PS4", false},
+		{"LD_PRELOAD", false},
+		{"LD_LIBRARY_PATH", false},
+		{"PATH", false},
+		{"IFS", false},
+	}
+	for _, tt := range tests {
+		err := checkPolicyEnv(map[string]string{tt.key: "x"})
+		if (err == nil) != tt.allowed {
+			t.Errorf("env %s: err = %v, want allowed = %v", tt.key, err, tt.allowed)
+		}
+	}
+}
+
+func TestCheckInteractivePolicy(t *testing.T) {
+	t.Setenv("ROOT_TOKENS", "admin")
+	if err := checkInteractivePolicy(tokenContext("tok")); err != nil {
+		t.Errorf("checkInteractivePolicy without a policy: %v", err)
+	}
+
+	useTestPolicy(t, `{"rules": [{"name": "status", "action": "allow", "argv_prefix": ["systemctl", "status"]}]}`)
+	tests := []struct {
+		token   string
+		allowed bool
+	}{
+		{"admin", true},
+		{"tok", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		err := checkInteractivePolicy(tokenContext(tt.token))
+		if (err == nil) != tt.allowed {
+			t.Errorf("token %q: err = %v, want allowed = %v", tt.token, err, tt.allowed)
+		}
+	}
+
+	// 未设置 ROOT_TOKENS 时所有令牌都可以以 root 执行
+	t.Setenv("ROOT_TOKENS", "")
+	if err := checkInteractivePolicy(tokenContext("tok")); err != nil {
+		t.Errorf("checkInteractivePolicy without ROOT_TOKENS: %v", err)
+	}
+}
+
+// useTestPolicy 加载策略并在测试结束后恢复为未配置策略
+func useTestPolicy(t *testing.T, data string) {
+	t.Helper()
+	p, err := loadPolicy(writePolicy(t, data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy = p
+	t.Cleanup(func() { policy = nil })
+}
+
+func writePolicy(t *testing.T, data string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(file, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func tokenContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", token))
+}
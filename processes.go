@@ -0,0 +1,271 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "server_agent/module/proto"
+
+	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/process"
+	"golang.org/x/sys/unix"
+)
+
+// processSampleWindow 是计算进程 CPU 使用率的采样窗口
+const processSampleWindow = 500 * time.Millisecond
+
+// ListProcesses 返回进程列表，可按 CPU 或内存排序并只取前 N 个
+func (s *ResourceCheckerServer) ListProcesses(ctx context.Context, req *pb.ListProcessesRequest) (resp *pb.ListProcessesResponse, err error) {
+	audit := newAuditEntry(ctx, "ListProcesses")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	// 命令行中可能带有密码等敏感参数
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	var less func(a, b *pb.ProcessInfo) bool
+	switch req.SortBy {
+	case "", "pid":
+		less = func(a, b *pb.ProcessInfo) bool { return a.Pid < b.Pid }
+	case "cpu":
+		less = func(a, b *pb.ProcessInfo) bool { return a.CpuPercent > b.CpuPercent }
+	case "memory":
+		less = func(a, b *pb.ProcessInfo) bool { return a.Rss > b.Rss }
+	default:
+		return nil, fmt.Errorf("不支持的排序字段: %s", req.SortBy)
+	}
+
+	procs, err := process.ProcessesWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取进程列表失败: %v", err)
+	}
+	infos, err := sampleProcesses(ctx, procs)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(infos, func(i, j int) bool { return less(infos[i], infos[j]) })
+
+	resp = &pb.ListProcessesResponse{Processes: infos, Total: int32(len(infos))}
+	if req.Limit > 0 && int(req.Limit) < len(infos) {
+		resp.Processes = infos[:req.Limit]
+	}
+	audit.OutputSize = int64(len(resp.Processes))
+	return resp, nil
+}
+
+// GetProcess 返回单个进程的信息
+func (s *ResourceCheckerServer) GetProcess(ctx context.Context, req *pb.ProcessRequest) (resp *pb.ProcessInfo, err error) {
+	audit := newAuditEntry(ctx, "GetProcess")
+	audit.Target = fmt.Sprint(req.Pid)
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	p, err := process.NewProcessWithContext(ctx, req.Pid)
+	if err != nil {
+		return nil, fmt.Errorf("进程 %d 不存在", req.Pid)
+	}
+	infos, err := sampleProcesses(ctx, []*process.Process{p})
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) == 0 {
+		return nil, fmt.Errorf("进程 %d 不存在", req.Pid)
+	}
+	return infos[0], nil
+}
+
+// SignalProcess 向进程发送信号
+func (s *ResourceCheckerServer) SignalProcess(ctx context.Context, req *pb.SignalProcessRequest) (resp *pb.SignalProcessResponse, err error) {
+	audit := newAuditEntry(ctx, "SignalProcess")
+	audit.Target = fmt.Sprint(req.Pid)
+	audit.Command = req.Signal
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	sig, err := parseSignal(req.Signal)
+	if err != nil {
+		return nil, err
+	}
+	p, err := controllableProcess(ctx, req.Pid, req.Force)
+	if err != nil {
+		return nil, err
+	}
+	name, _ := p.NameWithContext(ctx)
+	if err := syscall.Kill(int(req.Pid), sig); err != nil {
+		return nil, fmt.Errorf("向进程 %d 发送 %s 失败: %v", req.Pid, signalName(sig), err)
+	}
+	fmt.Printf("向进程 %d (%s) 发送信号 %s\n", req.Pid, name, signalName(sig))
+	return &pb.SignalProcessResponse{Pid: req.Pid, Name: name, Signal: signalName(sig)}, nil
+}
+
+// SetProcessPriority 调整进程的 nice 值和 IO 调度优先级，返回调整后的进程信息
+func (s *ResourceCheckerServer) SetProcessPriority(ctx context.Context, req *pb.SetProcessPriorityRequest) (resp *pb.ProcessInfo, err error) {
+	audit := newAuditEntry(ctx, "SetProcessPriority")
+	audit.Target = fmt.Sprint(req.Pid)
+	var changes []string
+	if req.Nice != nil {
+		changes = append(changes, fmt.Sprintf("nice=%d", *req.Nice))
+	}
+	if req.IoniceClass != "" {
+		changes = append(changes, fmt.Sprintf("ionice=%s:%d", req.IoniceClass, req.IoniceLevel))
+	}
+	audit.Command = strings.Join(changes, " ")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("需要指定 nice 或 ionice_class")
+	}
+	if req.Nice != nil && (*req.Nice < -20 || *req.Nice > 19) {
+		return nil, fmt.Errorf("nice 必须在 -20 到 19 之间: %d", *req.Nice)
+	}
+	p, err := controllableProcess(ctx, req.Pid, req.Force)
+	if err != nil {
+		return nil, err
+	}
+	if req.Nice != nil {
+		if err := unix.Setpriority(unix.PRIO_PROCESS, int(req.Pid), int(*req.Nice)); err != nil {
+			return nil, fmt.Errorf("设置 nice 失败: %v", err)
+		}
+	}
+	if req.IoniceClass != "" {
+		if err := setIOPriority(req.Pid, req.IoniceClass, req.IoniceLevel); err != nil {
+			return nil, err
+		}
+	}
+
+	var memTotal uint64
+	if vm, err := mem.VirtualMemory(); err == nil {
+		memTotal = vm.Total
+	}
+	return newProcessInfo(ctx, p, memTotal), nil
+}
+
+// controllableProcess 检查进程是否存在以及是否允许被控制。
+// 误杀 PID 1 或 agent 自身会导致主机或 agent 失联，必须显式设置 force
+func controllableProcess(ctx context.Context, pid int32, force bool) (*process.Process, error) {
+	if pid <= 0 {
+		return nil, fmt.Errorf("无效的 PID: %d", pid)
+	}
+	if !force && (pid == 1 || int(pid) == os.Getpid()) {
+		return nil, fmt.Errorf("拒绝操作进程 %d（PID 1 或 agent 自身），如确有需要请设置 force", pid)
+	}
+	p, err := process.NewProcessWithContext(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("进程 %d 不存在", pid)
+	}
+	return p, nil
+}
+
+// sampleProcesses 在采样窗口前后各读取一次 CPU 时间来计算使用率，
+// 窗口内退出的进程不出现在结果中
+func sampleProcesses(ctx context.Context, procs []*process.Process) ([]*pb.ProcessInfo, error) {
+	before := make(map[int32]float64, len(procs))
+	for _, p := range procs {
+		if t, err := p.TimesWithContext(ctx); err == nil {
+			before[p.Pid] = t.User + t.System
+		}
+	}
+	start := time.Now()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(processSampleWindow):
+	}
+	elapsed := time.Since(start).Seconds()
+
+	var memTotal uint64
+	if vm, err := mem.VirtualMemory(); err == nil {
+		memTotal = vm.Total
+	}
+
+	var infos []*pb.ProcessInfo
+	for _, p := range procs {
+		t, err := p.TimesWithContext(ctx)
+		if err != nil {
+			continue
+		}
+		info := newProcessInfo(ctx, p, memTotal)
+		if prev, ok := before[p.Pid]; ok && elapsed > 0 {
+			info.CpuPercent = roundToTwoDecimalPlaces((t.User + t.System - prev) / elapsed * 100)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// newProcessInfo 读取进程的静态信息，个别字段读取失败（如没有权限读取 fd 目录）时留空
+func newProcessInfo(ctx context.Context, p *process.Process, memTotal uint64) *pb.ProcessInfo {
+	info := &pb.ProcessInfo{Pid: p.Pid, Cgroup: processCgroup(p.Pid)}
+	info.Ppid, _ = p.PpidWithContext(ctx)
+	info.Name, _ = p.NameWithContext(ctx)
+	info.Cmdline, _ = p.CmdlineWithContext(ctx)
+	info.State, _ = p.StatusWithContext(ctx)
+	info.NumFds, _ = p.NumFDsWithContext(ctx)
+	info.NumThreads, _ = p.NumThreadsWithContext(ctx)
+	info.StartTime, _ = p.CreateTimeWithContext(ctx)
+	// 系统调用返回 20-nice，gopsutil 未做换算，这里直接读取
+	if prio, err := unix.Getpriority(unix.PRIO_PROCESS, int(p.Pid)); err == nil {
+		info.Nice = int32(20 - prio)
+	}
+	info.IoniceClass, info.IoniceLevel, _ = getIOPriority(p.Pid)
+	// 与 ps 一致显示有效用户
+	if uids, err := p.UidsWithContext(ctx); err == nil && len(uids) > 1 {
+		info.User = userName(uint32(uids[1]))
+	}
+	if m, err := p.MemoryInfoWithContext(ctx); err == nil {
+		info.Rss = m.RSS
+		if memTotal > 0 {
+			info.MemoryPercent = roundToTwoDecimalPlaces(float64(m.RSS) / float64(memTotal) * 100)
+		}
+	}
+	return info
+}
+
+// processCgroup 返回进程所在的 cgroup 路径。cgroup v2 只有一行 "0::/path"，
+// v1 下取 systemd 层级的路径，它反映进程所属的服务
+func processCgroup(pid int32) string {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return ""
+	}
+	var first string
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		if (parts[0] == "0" && parts[1] == "") || parts[1] == "name=systemd" {
+			return parts[2]
+		}
+		if first == "" {
+			first = parts[2]
+		}
+	}
+	return first
+}
@@ -1,3 +1,5 @@
+//go:build linux
+
 package main
 
 import (
@@ -6,7 +8,13 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	pb "server_agent/module/proto"
@@ -25,18 +33,14 @@ func roundToTwoDecimalPlaces(value float64) float64 {
 	return math.Round(value*100) / 100
 }
 
-// CheckResources 获取系统资源信息
-func CheckResources() (map[string]interface{}, error) {
+// collectResources 采集系统资源信息，CPU 使用率和实时网速需要两次采样求差值，
+// 由后台采样器根据相邻两次计数器计算后传入
+func collectResources(cpuUsage float64, netTotal gopsutilNet.IOCountersStat, netSpeed map[string]float64) (map[string]interface{}, error) {
 	hostInfo, err := host.Info()
 	if err != nil {
 		return nil, fmt.Errorf("获取主机信息失败: %v", err)
 	}
 
-	cpuUsage, err := getCpuUsage()
-	if err != nil {
-		return nil, fmt.Errorf("获取 CPU 使用率失败: %v", err)
-	}
-
 	memStat, err := mem.VirtualMemory()
 	if err != nil {
 		return nil, fmt.Errorf("获取内存使用率失败: %v", err)
@@ -57,22 +61,9 @@ func CheckResources() (map[string]interface{}, error) {
 		return nil, fmt.Errorf("获取负载平均值失败: %v", err)
 	}
 
-	// 获取网络上传和下载速度
-	netIO, err := gopsutilNet.IOCounters(false)
-	if err != nil {
-		return nil, fmt.Errorf("获取网络 IO 计数器失败: %v", err)
-	}
-	var netUploadSpeed, netDownloadSpeed float64
-	if len(netIO) > 0 {
-		netUploadSpeed = float64(netIO[0].BytesSent) / 1024 / 1024 / 1024   // 转换为 GB
-		netDownloadSpeed = float64(netIO[0].BytesRecv) / 1024 / 1024 / 1024 // 转换为 GB
-	}
-
-	// 获取实时网络速度
-	netSpeed, err := getRealTimeNetSpeed()
-	if err != nil {
-		return nil, fmt.Errorf("获取实时网络速度失败: %v", err)
-	}
+	// 网络累计流量，只统计参与统计的网卡，避免虚拟网卡重复计数
+	netUploadSpeed := float64(netTotal.BytesSent) / 1024 / 1024 / 1024   // 转换为 GB
+	netDownloadSpeed := float64(netTotal.BytesRecv) / 1024 / 1024 / 1024 // 转换为 GB
 
 	// 获取 CPU 核数
 	cpuCount, err := cpu.Counts(true)
@@ -107,48 +98,168 @@ func CheckResources() (map[string]interface{}, error) {
 	}, nil
 }
 
-// 获取实时网络速度
-func getRealTimeNetSpeed() (map[string]float64, error) {
-	netIO1, err := gopsutilNet.IOCounters(false)
-	if err != nil {
-		return nil, err
+// calculateNetSpeed 根据两次网络计数器的差值计算上传和下载速度（MB/s）
+func calculateNetSpeed(prev, cur gopsutilNet.IOCountersStat, elapsed time.Duration) map[string]float64 {
+	var uploadSpeed, downloadSpeed float64
+	if seconds := elapsed.Seconds(); seconds > 0 {
+		// 计数器回绕或网卡重置时不计算，避免出现巨大的无符号差值
+		if cur.BytesSent >= prev.BytesSent {
+			uploadSpeed = float64(cur.BytesSent-prev.BytesSent) / 1024 / 1024 / seconds // 转换为 MB/s
+		}
+		if cur.BytesRecv >= prev.BytesRecv {
+			downloadSpeed = float64(cur.BytesRecv-prev.BytesRecv) / 1024 / 1024 / seconds // 转换为 MB/s
+		}
 	}
-	time.Sleep(1 * time.Second)
-	netIO2, err := gopsutilNet.IOCounters(false)
-	if err != nil {
-		return nil, err
+
+	return map[string]float64{
+		"upload_speed":   roundToTwoDecimalPlaces(uploadSpeed),
+		"download_speed": roundToTwoDecimalPlaces(downloadSpeed),
+	}
+}
+
+// calculateDiskIO 根据两次磁盘计数器的差值计算每个块设备的吞吐量、IOPS、平均等待时间和利用率，
+// 算法与 iostat 一致。计数器回绕或设备在两次采样间出现、消失时跳过该设备
+func calculateDiskIO(prev, cur map[string]disk.IOCountersStat, elapsed time.Duration) []*pb.DiskIOInfo {
+	seconds := elapsed.Seconds()
+	if seconds <= 0 {
+		return nil
+	}
+	names := make([]string, 0, len(cur))
+	for name := range cur {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []*pb.DiskIOInfo
+	for _, name := range names {
+		c := cur[name]
+		p, ok := prev[name]
+		if !ok || c.ReadBytes < p.ReadBytes || c.WriteBytes < p.WriteBytes || c.ReadCount < p.ReadCount ||
+			c.WriteCount < p.WriteCount || c.ReadTime < p.ReadTime || c.WriteTime < p.WriteTime || c.IoTime < p.IoTime {
+			continue
+		}
+		info := &pb.DiskIOInfo{
+			Device:           name,
+			ReadBytesPerSec:  roundToTwoDecimalPlaces(float64(c.ReadBytes-p.ReadBytes) / seconds),
+			WriteBytesPerSec: roundToTwoDecimalPlaces(float64(c.WriteBytes-p.WriteBytes) / seconds),
+			ReadIops:         roundToTwoDecimalPlaces(float64(c.ReadCount-p.ReadCount) / seconds),
+			WriteIops:        roundToTwoDecimalPlaces(float64(c.WriteCount-p.WriteCount) / seconds),
+			// IoTime 是设备有 IO 在处理的累计毫秒数
+			UtilPercent: roundToTwoDecimalPlaces(math.Min(100, float64(c.IoTime-p.IoTime)/(seconds*1000)*100)),
+		}
+		if ios := (c.ReadCount - p.ReadCount) + (c.WriteCount - p.WriteCount); ios > 0 {
+			info.AwaitMs = roundToTwoDecimalPlaces(float64((c.ReadTime-p.ReadTime)+(c.WriteTime-p.WriteTime)) / float64(ios))
+		}
+		result = append(result, info)
 	}
+	return result
+}
 
-	if len(netIO1) == 0 || len(netIO2) == 0 {
-		return nil, fmt.Errorf("无法获取网络 IO 数据")
+// isWholeDisk 判断设备是否为整块磁盘，分区、loop 和 ram 设备不单独上报
+func isWholeDisk(name string) bool {
+	if strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") {
+		return false
 	}
+	_, err := os.Stat(filepath.Join("/sys/block", name))
+	return err == nil
+}
 
-	uploadSpeed := float64(netIO2[0].BytesSent-netIO1[0].BytesSent) / 1024 / 1024   // 转换为 MB/s
-	downloadSpeed := float64(netIO2[0].BytesRecv-netIO1[0].BytesRecv) / 1024 / 1024 // 转换为 MB/s
+// calculateCPUBusy 根据两次 CPU 时间采样计算使用率，算法与 cpu.Percent 保持一致
+func calculateCPUBusy(prev, cur cpu.TimesStat) float64 {
+	prevAll, prevBusy := cpuAllBusy(prev)
+	curAll, curBusy := cpuAllBusy(cur)
+	if curBusy <= prevBusy {
+		return 0
+	}
+	if curAll <= prevAll {
+		return 100
+	}
+	return math.Min(100, math.Max(0, (curBusy-prevBusy)/(curAll-prevAll)*100))
+}
 
-	return map[string]float64{
-		"upload_speed":   roundToTwoDecimalPlaces(uploadSpeed),
-		"download_speed": roundToTwoDecimalPlaces(downloadSpeed),
-	}, nil
+func cpuAllBusy(t cpu.TimesStat) (float64, float64) {
+	busy := t.User + t.System + t.Nice + t.Iowait + t.Irq + t.Softirq + t.Steal
+	return busy + t.Idle, busy
 }
 
-// 获取 CPU 使用率
-func getCpuUsage() (float64, error) {
-	percentages, err := cpu.Percent(1*time.Second, false)
-	if err != nil {
-		return 0, err
+// calculateCPUTimes 计算两次采样之间各类 CPU 时间占总时间的百分比
+func calculateCPUTimes(prev, cur cpu.TimesStat) *pb.CpuTimes {
+	prevAll, _ := cpuAllBusy(prev)
+	curAll, _ := cpuAllBusy(cur)
+	total := curAll - prevAll
+	if total <= 0 {
+		return &pb.CpuTimes{}
+	}
+	percent := func(before, after float64) float64 {
+		return roundToTwoDecimalPlaces(math.Max(0, after-before) / total * 100)
+	}
+	return &pb.CpuTimes{
+		User:    percent(prev.User, cur.User),
+		System:  percent(prev.System, cur.System),
+		Idle:    percent(prev.Idle, cur.Idle),
+		Nice:    percent(prev.Nice, cur.Nice),
+		Iowait:  percent(prev.Iowait, cur.Iowait),
+		Irq:     percent(prev.Irq, cur.Irq),
+		Softirq: percent(prev.Softirq, cur.Softirq),
+		Steal:   percent(prev.Steal, cur.Steal),
+	}
+}
+
+var (
+	cpuInfoOnce sync.Once
+	cpuInfos    []cpu.InfoStat
+)
+
+// getCPUInfo 返回 CPU 型号、当前平均频率和最大频率（MHz）。
+// 型号信息不会变化只读取一次；当前频率优先取 cpufreq 的 scaling_cur_freq，
+// 虚拟机等没有 cpufreq 的环境退回 /proc/cpuinfo 中的值
+func getCPUInfo() (string, float64, float64) {
+	cpuInfoOnce.Do(func() {
+		cpuInfos, _ = cpu.Info()
+	})
+	if len(cpuInfos) == 0 {
+		return "", 0, 0
+	}
+	model := cpuInfos[0].ModelName
+
+	files, _ := filepath.Glob("/sys/devices/system/cpu/cpu[0-9]*/cpufreq/scaling_cur_freq")
+	var total float64
+	var count int
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		khz, err := strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
+		if err != nil {
+			continue
+		}
+		total += khz / 1000
+		count++
 	}
-	if len(percentages) > 0 {
-		return percentages[0], nil
+	if count > 0 {
+		// 有 cpufreq 时 gopsutil 返回的 Mhz 是 cpuinfo_max_freq
+		return model, roundToTwoDecimalPlaces(total / float64(count)), roundToTwoDecimalPlaces(cpuInfos[0].Mhz)
 	}
-	return 0, fmt.Errorf("没有可用的 CPU 数据")
+
+	for _, info := range cpuInfos {
+		total += info.Mhz
+	}
+	return model, roundToTwoDecimalPlaces(total / float64(len(cpuInfos))), 0
 }
 
-// 检查 WebShell 支持
+var (
+	webShellOnce      sync.Once
+	webShellSupported bool
+)
+
+// 检查 WebShell 支持，结果不会变化，只在第一次采样时检查
 func checkWebShellSupport() bool {
-	cmd := exec.Command("which", "bash")
-	err := cmd.Run()
-	return err == nil
+	webShellOnce.Do(func() {
+		cmd := exec.Command("which", "bash")
+		webShellSupported = cmd.Run() == nil
+	})
+	return webShellSupported
 }
 
 // 获取网络接口 IP 信息
@@ -174,7 +285,8 @@ func GetIPAddresses() ([]string, error) {
 			case *net.IPAddr:
 				ip = v.IP
 			}
-			if ip != nil && ip.To4() != nil {
+			// IPv6 链路本地地址每块网卡都有，不具备区分意义
+			if ip != nil && !ip.IsLinkLocalUnicast() {
 				ips = append(ips, ip.String())
 			}
 		}
@@ -182,54 +294,167 @@ func GetIPAddresses() ([]string, error) {
 	return ips, nil
 }
 
+// calculateCPUPercent 与 docker stats 的算法一致。cgroup v2 下 PercpuUsage 为空，
+// 需要使用 OnlineCPUs 作为核数
 func calculateCPUPercent(stats types.StatsJSON) float64 {
 	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
 	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
 	if systemDelta > 0.0 && cpuDelta > 0.0 {
-		return (cpuDelta / systemDelta) * float64(len(stats.CPUStats.CPUUsage.PercpuUsage)) * 100.0
+		return (cpuDelta / systemDelta) * onlineCPUs * 100.0
 	}
 	return 0.0
 }
 
+// containerMemoryUsage 返回扣除非活跃页缓存后的内存用量，与 docker stats 一致。
+// cgroup v1 的字段为 total_inactive_file，v2 为 inactive_file
+func containerMemoryUsage(memory types.MemoryStats) uint64 {
+	for _, key := range []string{"total_inactive_file", "inactive_file"} {
+		if cache, ok := memory.Stats[key]; ok && cache < memory.Usage {
+			return memory.Usage - cache
+		}
+	}
+	return memory.Usage
+}
+
+// containerBlockIO 汇总块设备的读写字节数，cgroup v2 下操作名为小写
+func containerBlockIO(blkio types.BlkioStats) (read, write uint64) {
+	for _, entry := range blkio.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			read += entry.Value
+		case "write":
+			write += entry.Value
+		}
+	}
+	return read, write
+}
+
+// 并发获取容器统计信息的协程数，以及列出容器和查询单个容器的超时时间
+const (
+	dockerStatsWorkers = 8
+	dockerStatsTimeout = 5 * time.Second
+)
+
 func GetDockerInfo() ([]*pb.ContainerInfo, bool) {
-	cli, err := client.NewClientWithOpts(client.FromEnv)
+	cli, err := newDockerClient()
 	if err != nil {
 		return nil, false
 	}
 	defer cli.Close()
 
-	containers, err := cli.ContainerList(context.Background(), types.ContainerListOptions{All: true})
+	// dockerd 无响应时不能让采集协程一直阻塞，否则会持续报告过期的容器列表
+	ctx, cancel := context.WithTimeout(context.Background(), dockerStatsTimeout)
+	containers, err := cli.ContainerList(ctx, types.ContainerListOptions{All: true})
+	cancel()
 	if err != nil {
 		return nil, false
 	}
 
-	var containerInfos []*pb.ContainerInfo
-	for _, container := range containers {
-		stats, err := cli.ContainerStatsOneShot(context.Background(), container.ID)
-		if err != nil {
-			return nil, false
-		}
+	containerInfos := make([]*pb.ContainerInfo, len(containers))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < dockerStatsWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				containerInfos[i] = getContainerInfo(cli, containers[i])
+			}
+		}()
+	}
+	for i := range containers {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return containerInfos, true
+}
 
-		var statsJSON types.StatsJSON
-		if err := json.NewDecoder(stats.Body).Decode(&statsJSON); err != nil {
-			return nil, false
-		}
+// getContainerInfo 获取单个容器的信息，只有运行中的容器才查询统计信息，
+// 查询失败时在 error 中说明原因，不影响其他容器
+func getContainerInfo(cli *client.Client, container types.Container) *pb.ContainerInfo {
+	info := &pb.ContainerInfo{
+		Id:      container.ID[:12],
+		FullId:  container.ID,
+		Image:   container.Image,
+		Status:  container.Status,
+		State:   container.State,
+		Created: container.Created,
+		Labels:  container.Labels,
+	}
+	if len(container.Names) > 0 {
+		info.Name = container.Names[0]
+	}
+	for _, port := range container.Ports {
+		info.Ports = append(info.Ports, &pb.ContainerPort{
+			Ip:          port.IP,
+			PrivatePort: uint32(port.PrivatePort),
+			PublicPort:  uint32(port.PublicPort),
+			Type:        port.Type,
+		})
+	}
 
-		var memoryUsage float64
-		if statsJSON.MemoryStats.Usage != 0 {
-			memoryUsage = float64(statsJSON.MemoryStats.Usage) / (1024 * 1024) // 转换为 MB
+	ctx, cancel := context.WithTimeout(context.Background(), dockerStatsTimeout)
+	defer cancel()
+	// 重启次数、健康状态和启动时间只能通过 inspect 获取
+	inspect, err := cli.ContainerInspect(ctx, container.ID)
+	if err != nil {
+		info.Error = fmt.Sprintf("获取容器详情失败: %v", err)
+		return info
+	}
+	info.RestartCount = int32(inspect.RestartCount)
+	if inspect.State != nil {
+		if inspect.State.Health != nil {
+			info.Health = inspect.State.Health.Status
+		}
+		if started, err := time.Parse(time.RFC3339Nano, inspect.State.StartedAt); err == nil && started.Year() > 1 {
+			info.StartedAt = started.Unix()
 		}
+	}
+	if container.State != "running" {
+		return info
+	}
 
-		cpuUsage := calculateCPUPercent(statsJSON)
+	// one-shot 模式下守护进程不填充 precpu_stats，CPU 使用率恒为 0；
+	// 非流式请求会多采样一次，约 1 秒后返回两次采样之间的数据
+	stats, err := cli.ContainerStats(ctx, container.ID, false)
+	if err != nil {
+		info.Error = fmt.Sprintf("获取统计信息失败: %v", err)
+		return info
+	}
+	defer stats.Body.Close()
 
-		containerInfos = append(containerInfos, &pb.ContainerInfo{
-			Id:          container.ID[:12],
-			Name:        container.Names[0],
-			Image:       container.Image,
-			Status:      container.Status,
-			MemoryUsage: fmt.Sprintf("%.2f MB", memoryUsage),
-			CpuUsage:    fmt.Sprintf("%.2f%%", cpuUsage),
-		})
+	var statsJSON types.StatsJSON
+	if err := json.NewDecoder(stats.Body).Decode(&statsJSON); err != nil {
+		info.Error = fmt.Sprintf("解析统计信息失败: %v", err)
+		return info
 	}
-	return containerInfos, true
+
+	var memoryUsage float64
+	if statsJSON.MemoryStats.Usage != 0 {
+		memoryUsage = float64(statsJSON.MemoryStats.Usage) / (1024 * 1024) // 转换为 MB
+	}
+
+	cpuUsage := calculateCPUPercent(statsJSON)
+
+	info.MemoryUsage = fmt.Sprintf("%.2f MB", memoryUsage)
+	info.CpuUsage = fmt.Sprintf("%.2f%%", cpuUsage)
+
+	info.CpuPercent = roundToTwoDecimalPlaces(cpuUsage)
+	info.MemoryUsageBytes = containerMemoryUsage(statsJSON.MemoryStats)
+	info.MemoryLimitBytes = statsJSON.MemoryStats.Limit
+	if info.MemoryLimitBytes > 0 {
+		info.MemoryPercent = roundToTwoDecimalPlaces(float64(info.MemoryUsageBytes) / float64(info.MemoryLimitBytes) * 100)
+	}
+	for _, network := range statsJSON.Networks {
+		info.NetRxBytes += network.RxBytes
+		info.NetTxBytes += network.TxBytes
+	}
+	info.BlockReadBytes, info.BlockWriteBytes = containerBlockIO(statsJSON.BlkioStats)
+	info.Pids = statsJSON.PidsStats.Current
+	return info
 }
@@ -0,0 +1,183 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "server_agent/module/proto"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+	gopsutilNet "github.com/shirou/gopsutil/net"
+	"google.golang.org/protobuf/proto"
+)
+
+// resourceSampler 在后台按固定周期采样系统资源并保存最新快照，
+// CheckResources 和 WatchResources 都直接读取快照，避免每个请求各自阻塞采样
+type resourceSampler struct {
+	interval       time.Duration
+	dockerInterval time.Duration
+
+	once  sync.Once
+	ready chan struct{}
+
+	mu              sync.RWMutex
+	latest          *pb.ResourceResponse
+	err             error
+	containers      []*pb.ContainerInfo
+	dockerAvailable bool
+}
+
+var sampler = newResourceSampler(1*time.Second, 5*time.Second)
+
+func newResourceSampler(interval, dockerInterval time.Duration) *resourceSampler {
+	return &resourceSampler{
+		interval:       interval,
+		dockerInterval: dockerInterval,
+		ready:          make(chan struct{}),
+	}
+}
+
+// Start 启动后台采样协程，重复调用无副作用
+func (s *resourceSampler) Start() {
+	s.once.Do(func() {
+		go s.run()
+		go s.runDocker()
+	})
+}
+
+// Latest 返回最新的资源快照，首次采样完成前会阻塞等待。
+// 返回的消息会被多个请求共享，调用方不得修改
+func (s *resourceSampler) Latest(ctx context.Context) (*pb.ResourceResponse, error) {
+	select {
+	case <-s.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.latest, s.err
+}
+
+func (s *resourceSampler) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	prev, _ := readCounters()
+	for range ticker.C {
+		cur, err := readCounters()
+		if err != nil {
+			s.publish(nil, err)
+			continue
+		}
+		if prev != nil {
+			s.publish(buildSnapshot(prev, cur))
+		}
+		prev = cur
+	}
+}
+
+// buildSnapshot 根据相邻两次计数器的差值和当前的系统状态生成资源快照
+func buildSnapshot(prev, cur *counters) (*pb.ResourceResponse, error) {
+	cpuUsage := calculateCPUBusy(prev.cpu, cur.cpu)
+	netSpeed := calculateNetSpeed(prev.net, cur.net, cur.at.Sub(prev.at))
+	data, err := collectResources(cpuUsage, cur.net, netSpeed)
+	if err != nil {
+		return nil, err
+	}
+	resp := newResourceResponse(data)
+
+	// 获取网络接口信息
+	ipAddresses, err := GetIPAddresses()
+	if err != nil {
+		ipAddresses = []string{"无法获取 IP"}
+	}
+	resp.IpAddresses = ipAddresses
+
+	if len(prev.perCore) == len(cur.perCore) {
+		for i := range cur.perCore {
+			resp.CpuPerCore = append(resp.CpuPerCore, roundToTwoDecimalPlaces(calculateCPUBusy(prev.perCore[i], cur.perCore[i])))
+		}
+	}
+	resp.CpuTimes = calculateCPUTimes(prev.cpu, cur.cpu)
+	resp.CpuModel, resp.CpuMhz, resp.CpuMaxMhz = getCPUInfo()
+	resp.Interfaces = collectInterfaces(prev.nics, cur.nics, cur.at.Sub(prev.at))
+	resp.DiskIo = calculateDiskIO(prev.disk, cur.disk, cur.at.Sub(prev.at))
+	if filesystems, err := collectFilesystems(); err == nil {
+		resp.Filesystems = filesystems
+	}
+	return resp, nil
+}
+
+// runDocker 单独采集容器信息，逐个容器获取统计数据较慢，不能拖慢主采样周期
+func (s *resourceSampler) runDocker() {
+	for {
+		containers, dockerAvailable := GetDockerInfo()
+		s.mu.Lock()
+		s.containers, s.dockerAvailable = containers, dockerAvailable
+		s.mu.Unlock()
+		time.Sleep(s.dockerInterval)
+	}
+}
+
+func (s *resourceSampler) publish(resp *pb.ResourceResponse, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if resp != nil {
+		resp.Containers, resp.DockerAvailable = s.containers, s.dockerAvailable
+	}
+	s.latest, s.err = resp, err
+	select {
+	case <-s.ready:
+	default:
+		close(s.ready)
+	}
+}
+
+// withoutContainers 返回不含容器列表的快照副本
+func withoutContainers(resp *pb.ResourceResponse) *pb.ResourceResponse {
+	clone := proto.Clone(resp).(*pb.ResourceResponse)
+	clone.Containers = nil
+	return clone
+}
+
+// counters 是需要两次采样求差值的累计计数器
+type counters struct {
+	at      time.Time
+	cpu     cpu.TimesStat
+	perCore []cpu.TimesStat
+	net     gopsutilNet.IOCountersStat // 参与统计的网卡的合计
+	nics    map[string]gopsutilNet.IOCountersStat
+	disk    map[string]disk.IOCountersStat
+}
+
+// readCounters 读取 CPU 时间和网络 IO 的累计计数器
+func readCounters() (*counters, error) {
+	cpuTimes, err := cpu.Times(false)
+	if err != nil {
+		return nil, fmt.Errorf("获取 CPU 时间失败: %v", err)
+	}
+	perCore, err := cpu.Times(true)
+	if err != nil {
+		return nil, fmt.Errorf("获取 CPU 时间失败: %v", err)
+	}
+	nics, netTotal, err := readNetCounters()
+	if err != nil {
+		return nil, fmt.Errorf("获取网络 IO 计数器失败: %v", err)
+	}
+	if len(cpuTimes) == 0 {
+		return nil, fmt.Errorf("无法获取 CPU 数据")
+	}
+	// 没有块设备（如部分容器环境）时磁盘 IO 留空，不影响其他指标
+	diskIO, _ := disk.IOCounters()
+	for name := range diskIO {
+		if !isWholeDisk(name) {
+			delete(diskIO, name)
+		}
+	}
+	return &counters{at: time.Now(), cpu: cpuTimes[0], perCore: perCore, net: netTotal, nics: nics, disk: diskIO}, nil
+}
@@ -0,0 +1,392 @@
+//go:build linux
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	pb "server_agent/module/proto"
+
+	"github.com/creack/pty"
+	"golang.org/x/sys/unix"
+)
+
+// RunShell 执行客户端发来的 Shell 命令
+func (s *ResourceCheckerServer) RunShell(ctx context.Context, req *pb.ShellRequest) (resp *pb.ShellResponse, err error) {
+	audit := newAuditEntry(ctx, "RunShell")
+	audit.Command, audit.RunAsUser = req.Command, req.RunAsUser
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	cmdCtx, cancel := shellContext(ctx, req)
+	defer cancel()
+	cmd, err := newShellCommand(cmdCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("执行命令: %s\n", req.Command)
+
+	start := time.Now()
+	output, err := cmd.CombinedOutput()
+	exit := finishShell(cmdCtx, cmd, err, start)
+	audit.setExit(exit.ExitCode)
+	audit.OutputSize = int64(len(output))
+	resp = &pb.ShellResponse{
+		Output:   string(output),
+		ExitCode: exit.ExitCode,
+		Signal:   exit.Signal,
+		Duration: exit.Duration,
+		TimedOut: exit.TimedOut,
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	return resp, nil
+}
+
+// RunShellStream 执行 Shell 命令并在输出产生时分别推送 stdout 和 stderr 片段，
+// 最后一条消息携带退出码，输出不在内存中累积
+func (s *ResourceCheckerServer) RunShellStream(req *pb.ShellRequest, stream pb.ResourceChecker_RunShellStreamServer) (err error) {
+	audit := newAuditEntry(stream.Context(), "RunShellStream")
+	audit.Command, audit.RunAsUser = req.Command, req.RunAsUser
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(stream.Context()); err != nil {
+		return err
+	}
+	cmdCtx, cancel := shellContext(stream.Context(), req)
+	defer cancel()
+	cmd, err := newShellCommand(cmdCtx, req)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("执行命令: %s\n", req.Command)
+
+	// gRPC 流不允许并发 Send，exec 复制 stdout 和 stderr 的两个协程共用一把锁
+	var sendMu sync.Mutex
+	send := func(chunk *pb.ShellChunk) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(chunk)
+	}
+	cmd.Stdout = &shellChunkWriter{send: send, audit: audit, wrap: func(b []byte) *pb.ShellChunk {
+		return &pb.ShellChunk{Payload: &pb.ShellChunk_Stdout{Stdout: b}}
+	}}
+	cmd.Stderr = &shellChunkWriter{send: send, audit: audit, wrap: func(b []byte) *pb.ShellChunk {
+		return &pb.ShellChunk{Payload: &pb.ShellChunk_Stderr{Stderr: b}}
+	}}
+
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		exit := finishShell(cmdCtx, cmd, err, start)
+		audit.setExit(exit.ExitCode)
+		return stream.Send(&pb.ShellChunk{Payload: &pb.ShellChunk_Exit{Exit: exit}})
+	}
+
+	// Wait 等待 exec 复制完输出，后台子进程占用管道时由 WaitDelay 关闭管道
+	waitErr := cmd.Wait()
+	exit := finishShell(cmdCtx, cmd, waitErr, start)
+	audit.setExit(exit.ExitCode)
+	return send(&pb.ShellChunk{Payload: &pb.ShellChunk_Exit{Exit: exit}})
+}
+
+// shellChunkWriter 把命令的输出作为消息发送。输出由 exec 自己从管道复制，
+// 这样 Wait 才能在 WaitDelay 到期后关闭被后台子进程占用的管道
+type shellChunkWriter struct {
+	send  func(*pb.ShellChunk) error
+	wrap  func([]byte) *pb.ShellChunk
+	audit *auditEntry
+}
+
+func (w *shellChunkWriter) Write(p []byte) (int, error) {
+	// 客户端断开后仍返回成功，继续读完管道，避免子进程因管道写满而阻塞
+	w.send(w.wrap(append([]byte(nil), p...)))
+	atomic.AddInt64(&w.audit.OutputSize, int64(len(p)))
+	return len(p), nil
+}
+
+// shellContext 在请求上下文的基础上附加命令超时，客户端断开或超时都会终止命令
+func shellContext(ctx context.Context, req *pb.ShellRequest) (context.Context, context.CancelFunc) {
+	if req.TimeoutSeconds > 0 {
+		return context.WithTimeout(ctx, time.Duration(req.TimeoutSeconds)*time.Second)
+	}
+	return context.WithCancel(ctx)
+}
+
+// newShellCommand 根据请求构造通过 bash 执行的命令。命令在独立的进程组中运行，
+// 上下文取消时终止整个进程组，避免遗留子进程
+func newShellCommand(ctx context.Context, req *pb.ShellRequest) (*exec.Cmd, error) {
+	if err := checkCommandPolicy(ctx, req.Command); err != nil {
+		return nil, err
+	}
+	if err := checkPolicyEnv(req.Env); err != nil {
+		return nil, err
+	}
+	runAs, err := resolveRunAs(ctx, req.RunAsUser, req.RunAsGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "bash", "-c", req.Command)
+	cmd.Dir = req.WorkingDir
+	if len(req.Stdin) > 0 {
+		cmd.Stdin = bytes.NewReader(req.Stdin)
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Env = shellEnv(runAs.apply(cmd.SysProcAttr, req.Env), req.ClearEnv)
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	// 进程组被终止后，若仍有脱离进程组的子进程占用输出管道，最多再等待这么久
+	cmd.WaitDelay = 5 * time.Second
+	return cmd, nil
+}
+
+// sensitiveEnv 是不允许传递给远程命令的 agent 环境变量
+var sensitiveEnv = map[string]bool{
+	"AUTH_TOKEN":  true,
+	"ROOT_TOKENS": true,
+}
+
+// shellEnv 构造命令的环境变量：默认继承 agent 的环境（去掉敏感变量），
+// 再用请求中的变量覆盖；clearEnv 为 true 时只使用请求中的变量。
+// 配置了策略时，继承的环境中不保留 unsafePolicyEnv 中的变量，PATH 固定为 policyPath
+func shellEnv(overrides map[string]string, clearEnv bool) []string {
+	// env 不能为 nil，否则 exec 会继承 agent 的全部环境变量
+	env := []string{}
+	if !clearEnv {
+		if policy != nil {
+			if _, ok := overrides["PATH"]; !ok {
+				env = append(env, "PATH="+policyPath)
+			}
+		}
+		for _, kv := range os.Environ() {
+			key, _, _ := strings.Cut(kv, "=")
+			if sensitiveEnv[key] || (policy != nil && unsafePolicyEnv(key)) {
+				continue
+			}
+			if _, ok := overrides[key]; ok {
+				continue
+			}
+			env = append(env, kv)
+		}
+	}
+	for key, value := range overrides {
+		env = append(env, key+"="+value)
+	}
+	return env
+}
+
+// finishShell 汇总命令的退出码、终止信号、耗时以及是否超时
+func finishShell(ctx context.Context, cmd *exec.Cmd, waitErr error, start time.Time) *pb.ShellExit {
+	exit := exitInfo(cmd.ProcessState, waitErr)
+	exit.Duration = roundToTwoDecimalPlaces(time.Since(start).Seconds())
+	exit.TimedOut = errors.Is(ctx.Err(), context.DeadlineExceeded)
+	return exit
+}
+
+// OpenShell 打开交互式伪终端会话，双向转发输入输出，支持调整窗口大小和发送信号
+func (s *ResourceCheckerServer) OpenShell(stream pb.ResourceChecker_OpenShellServer) (err error) {
+	ctx := stream.Context()
+	audit := newAuditEntry(ctx, "OpenShell")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := first.GetStart()
+	if start == nil {
+		return fmt.Errorf("首条消息必须为 start")
+	}
+	audit.Command, audit.RunAsUser = start.Command, start.RunAsUser
+
+	// 未指定命令时启动登录 shell，策略按 "bash -l" 检查
+	args := []string{"-l"}
+	command := "bash -l"
+	if start.Command != "" {
+		args = []string{"-c", start.Command}
+		command = start.Command
+	}
+	if err := checkInteractivePolicy(ctx); err != nil {
+		return err
+	}
+	if err := checkCommandPolicy(ctx, command); err != nil {
+		return err
+	}
+	runAs, err := resolveRunAs(ctx, start.RunAsUser, start.RunAsGroup)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "bash", args...)
+	term := start.Term
+	if term == "" {
+		term = "xterm-256color"
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{}
+	cmd.Env = shellEnv(runAs.apply(cmd.SysProcAttr, map[string]string{"TERM": term}), false)
+	// pty 以新会话启动进程，会话断开时终止整个进程组
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	fmt.Printf("打开终端: %s\n", start.Command)
+
+	ptmx, err := pty.StartWithSize(cmd, toWinsize(start.Size))
+	if err != nil {
+		return fmt.Errorf("启动终端失败: %v", err)
+	}
+	defer ptmx.Close()
+
+	// 输出协程是唯一调用 Send 的地方，直到它结束后才发送退出信息
+	outputDone := make(chan struct{})
+	go func() {
+		defer close(outputDone)
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := ptmx.Read(buf)
+			if n > 0 {
+				out := &pb.ShellOutput{Payload: &pb.ShellOutput_Stdout{Stdout: append([]byte(nil), buf[:n]...)}}
+				if stream.Send(out) != nil {
+					return
+				}
+				audit.OutputSize += int64(n)
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			in, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			switch payload := in.Payload.(type) {
+			case *pb.ShellInput_Stdin:
+				ptmx.Write(payload.Stdin)
+			case *pb.ShellInput_Resize:
+				pty.Setsize(ptmx, toWinsize(payload.Resize))
+			case *pb.ShellInput_Signal:
+				sig, err := parseSignal(payload.Signal)
+				if err != nil {
+					continue
+				}
+				// 与在终端中按 Ctrl-C 一样发给前台进程组，这样中断的是正在运行的程序而不是 bash；
+				// 查询失败时退回 bash 所在的进程组（pty.Start 以新会话启动，进程组号即 bash 的 pid）
+				pgrp, err := foregroundProcessGroup(ptmx)
+				if err != nil || pgrp <= 0 {
+					pgrp = cmd.Process.Pid
+				}
+				syscall.Kill(-pgrp, sig)
+			}
+		}
+	}()
+
+	waitErr := cmd.Wait()
+
+	// 进程退出后终端会被挂断，正常情况下输出协程很快读完剩余数据；
+	// 若后台子进程仍占用终端，则强制关闭
+	select {
+	case <-outputDone:
+	case <-time.After(time.Second):
+		ptmx.Close()
+		<-outputDone
+	}
+
+	exit := exitInfo(cmd.ProcessState, waitErr)
+	audit.setExit(exit.ExitCode)
+	return stream.Send(&pb.ShellOutput{Payload: &pb.ShellOutput_Exit{Exit: exit}})
+}
+
+// foregroundProcessGroup 返回终端的前台进程组。通过 SyscallConn 取描述符，
+// 调用 Fd 会把它切换为阻塞模式，之后 Close 就无法打断输出协程中的 Read
+func foregroundProcessGroup(ptmx *os.File) (int, error) {
+	conn, err := ptmx.SyscallConn()
+	if err != nil {
+		return 0, err
+	}
+	var pgrp int
+	var ioctlErr error
+	if err := conn.Control(func(fd uintptr) {
+		pgrp, ioctlErr = unix.IoctlGetInt(int(fd), unix.TIOCGPGRP)
+	}); err != nil {
+		return 0, err
+	}
+	return pgrp, ioctlErr
+}
+
+func toWinsize(size *pb.WindowSize) *pty.Winsize {
+	if size == nil || size.Rows == 0 || size.Cols == 0 {
+		return &pty.Winsize{Rows: 24, Cols: 80}
+	}
+	return &pty.Winsize{Rows: uint16(size.Rows), Cols: uint16(size.Cols)}
+}
+
+// exitInfo 从进程状态中提取退出码和终止信号
+func exitInfo(state *os.ProcessState, waitErr error) *pb.ShellExit {
+	exit := &pb.ShellExit{}
+	var exitErr *exec.ExitError
+	if waitErr != nil && !errors.As(waitErr, &exitErr) {
+		exit.Error = waitErr.Error()
+	}
+	if state == nil {
+		exit.ExitCode = -1
+		return exit
+	}
+	exit.ExitCode = int32(state.ExitCode())
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		exit.Signal = signalName(status.Signal())
+	}
+	return exit
+}
+
+var signalNames = map[string]syscall.Signal{
+	"HUP":   syscall.SIGHUP,
+	"INT":   syscall.SIGINT,
+	"QUIT":  syscall.SIGQUIT,
+	"KILL":  syscall.SIGKILL,
+	"USR1":  syscall.SIGUSR1,
+	"USR2":  syscall.SIGUSR2,
+	"TERM":  syscall.SIGTERM,
+	"CONT":  syscall.SIGCONT,
+	"STOP":  syscall.SIGSTOP,
+	"TSTP":  syscall.SIGTSTP,
+	"WINCH": syscall.SIGWINCH,
+}
+
+// parseSignal 解析信号名，支持 "SIGTERM"、"TERM" 两种写法
+func parseSignal(name string) (syscall.Signal, error) {
+	name = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(name)), "SIG")
+	if sig, ok := signalNames[name]; ok {
+		return sig, nil
+	}
+	return 0, fmt.Errorf("不支持的信号: %s", name)
+}
+
+func signalName(sig syscall.Signal) string {
+	for name, s := range signalNames {
+		if s == sig {
+			return "SIG" + name
+		}
+	}
+	return sig.String()
+}
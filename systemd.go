@@ -0,0 +1,242 @@
+//go:build linux
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "server_agent/module/proto"
+)
+
+const (
+	defaultJournalLines = 100
+	maxJournalLines     = 10000
+)
+
+// unitNamePattern 限制单元名的字符，防止单元名被 systemctl 当作选项解析
+var unitNamePattern = regexp.MustCompile(`^[A-Za-z0-9:_.@\\][A-Za-z0-9:_.@\\-]*$`)
+
+// unitProperties 是 GetUnitStatus 需要的 systemctl show 属性
+var unitProperties = []string{
+	"Id", "Description", "LoadState", "ActiveState", "SubState", "UnitFileState", "MainPID",
+	"ActiveEnterTimestamp", "FragmentPath", "MemoryCurrent", "NRestarts", "Result",
+}
+
+// ListUnits 列出 systemd 单元及其状态
+func (s *ResourceCheckerServer) ListUnits(ctx context.Context, req *pb.ListUnitsRequest) (resp *pb.ListUnitsResponse, err error) {
+	audit := newAuditEntry(ctx, "ListUnits")
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	unitType := req.Type
+	if unitType == "" {
+		unitType = "service"
+	}
+	if !unitNamePattern.MatchString(unitType) {
+		return nil, fmt.Errorf("无效的单元类型: %s", unitType)
+	}
+	if req.Pattern != "" {
+		if _, err := filepath.Match(req.Pattern, ""); err != nil {
+			return nil, fmt.Errorf("无效的 glob 模式 %q: %v", req.Pattern, err)
+		}
+	}
+
+	out, err := systemctl(ctx, "list-units", "--all", "--plain", "--no-legend", "--type="+unitType)
+	if err != nil {
+		return nil, err
+	}
+	resp = &pb.ListUnitsResponse{}
+	for _, unit := range parseUnitList(out) {
+		if req.Pattern != "" {
+			if ok, _ := filepath.Match(req.Pattern, unit.Name); !ok {
+				continue
+			}
+		}
+		if req.State != "" && req.State != unit.LoadState && req.State != unit.ActiveState && req.State != unit.SubState {
+			continue
+		}
+		resp.Units = append(resp.Units, unit)
+	}
+	audit.OutputSize = int64(len(resp.Units))
+	return resp, nil
+}
+
+// GetUnitStatus 返回单元的当前状态
+func (s *ResourceCheckerServer) GetUnitStatus(ctx context.Context, req *pb.UnitRequest) (resp *pb.UnitStatus, err error) {
+	audit := newAuditEntry(ctx, "GetUnitStatus")
+	audit.Target = req.Unit
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if !unitNamePattern.MatchString(req.Unit) {
+		return nil, fmt.Errorf("无效的单元名: %q", req.Unit)
+	}
+	return unitStatus(ctx, req.Unit)
+}
+
+// ControlUnit 启动、停止、重启、重载、启用或禁用单元，返回操作后的状态
+func (s *ResourceCheckerServer) ControlUnit(ctx context.Context, req *pb.UnitActionRequest) (resp *pb.UnitStatus, err error) {
+	audit := newAuditEntry(ctx, "ControlUnit")
+	audit.Target = req.Unit
+	audit.Command = req.Action
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	switch req.Action {
+	case "start", "stop", "restart", "reload", "enable", "disable":
+	default:
+		return nil, fmt.Errorf("不支持的操作: %s", req.Action)
+	}
+	if !unitNamePattern.MatchString(req.Unit) {
+		return nil, fmt.Errorf("无效的单元名: %q", req.Unit)
+	}
+
+	if _, err := systemctl(ctx, req.Action, "--", req.Unit); err != nil {
+		return nil, err
+	}
+	fmt.Printf("systemctl %s %s\n", req.Action, req.Unit)
+	return unitStatus(ctx, req.Unit)
+}
+
+// GetUnitJournal 返回单元最近的日志
+func (s *ResourceCheckerServer) GetUnitJournal(ctx context.Context, req *pb.UnitJournalRequest) (resp *pb.UnitJournalResponse, err error) {
+	audit := newAuditEntry(ctx, "GetUnitJournal")
+	audit.Target = req.Unit
+	defer func() { audit.commit(err) }()
+
+	if err := AuthInterceptor(ctx); err != nil {
+		return nil, err
+	}
+	// 日志中可能包含敏感信息
+	if err := requireRootToken(ctx); err != nil {
+		return nil, err
+	}
+	if !unitNamePattern.MatchString(req.Unit) {
+		return nil, fmt.Errorf("无效的单元名: %q", req.Unit)
+	}
+	lines := int(req.Lines)
+	if lines <= 0 {
+		lines = defaultJournalLines
+	}
+	if lines > maxJournalLines {
+		lines = maxJournalLines
+	}
+
+	args := []string{"--unit=" + req.Unit, "--lines=" + strconv.Itoa(lines), "--no-pager", "--quiet", "--output=short-iso"}
+	if req.Since != "" {
+		args = append(args, "--since="+req.Since)
+	}
+	out, err := exec.CommandContext(ctx, "journalctl", args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("journalctl 执行失败: %v: %s", err, strings.TrimSpace(string(out)))
+	}
+	resp = &pb.UnitJournalResponse{}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		resp.Lines = append(resp.Lines, scanner.Text())
+	}
+	audit.OutputSize = int64(len(out))
+	return resp, nil
+}
+
+// systemctl 执行 systemctl 命令，失败时把 systemctl 的输出附在错误中
+func systemctl(ctx context.Context, args ...string) ([]byte, error) {
+	args = append([]string{"--no-pager", "--no-ask-password"}, args...)
+	cmd := exec.CommandContext(ctx, "systemctl", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("systemctl %s 失败: %v: %s", strings.Join(args[2:], " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// unitStatus 通过 systemctl show 读取单元属性
+func unitStatus(ctx context.Context, unit string) (*pb.UnitStatus, error) {
+	out, err := systemctl(ctx, "show", "--property="+strings.Join(unitProperties, ","), "--", unit)
+	if err != nil {
+		return nil, err
+	}
+	props := map[string]string{}
+	for _, line := range strings.Split(string(out), "\n") {
+		if key, value, ok := strings.Cut(line, "="); ok {
+			props[key] = value
+		}
+	}
+	status := &pb.UnitStatus{
+		Name:          props["Id"],
+		Description:   props["Description"],
+		LoadState:     props["LoadState"],
+		ActiveState:   props["ActiveState"],
+		SubState:      props["SubState"],
+		UnitFileState: props["UnitFileState"],
+		FragmentPath:  props["FragmentPath"],
+		Result:        props["Result"],
+	}
+	if pid, err := strconv.ParseInt(props["MainPID"], 10, 32); err == nil {
+		status.MainPid = int32(pid)
+	}
+	if restarts, err := strconv.ParseInt(props["NRestarts"], 10, 32); err == nil {
+		status.Restarts = int32(restarts)
+	}
+	// 未开启内存统计时为 "[not set]" 或 UINT64_MAX
+	if memory, err := strconv.ParseUint(props["MemoryCurrent"], 10, 64); err == nil && memory != ^uint64(0) {
+		status.MemoryCurrent = memory
+	}
+	if t, err := parseSystemdTimestamp(props["ActiveEnterTimestamp"]); err == nil {
+		status.ActiveSince = t.Unix()
+	}
+	return status, nil
+}
+
+// parseSystemdTimestamp 解析 systemctl show 输出的时间，如 "Sat 2026-10-17 13:00:00 CST"。
+// systemctl 继承 agent 的时区按本地时间输出，时区缩写不可靠（time.Parse 会把识别不了的缩写当作 UTC），
+// 因此去掉缩写后按本地时区解析
+func parseSystemdTimestamp(value string) (time.Time, error) {
+	if i := strings.LastIndexByte(value, ' '); i > 0 {
+		value = value[:i]
+	}
+	return time.ParseInLocation("Mon 2006-01-02 15:04:05", value, time.Local)
+}
+
+// parseUnitList 解析 systemctl list-units --plain --no-legend 的输出，
+// 每行依次为 UNIT LOAD ACTIVE SUB DESCRIPTION，失败或找不到的单元行首带有 ●
+func parseUnitList(out []byte) []*pb.UnitInfo {
+	var units []*pb.UnitInfo
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "●"))
+		fields := strings.Fields(line)
+		if len(fields) < 4 {
+			continue
+		}
+		unit := &pb.UnitInfo{Name: fields[0], LoadState: fields[1], ActiveState: fields[2], SubState: fields[3]}
+		// 描述中可能包含空格，取第四列之后的原始文本
+		rest := line
+		for i := 0; i < 4; i++ {
+			rest = strings.TrimSpace(strings.TrimPrefix(rest, fields[i]))
+		}
+		unit.Description = rest
+		units = append(units, unit)
+	}
+	return units
+}